@@ -0,0 +1,73 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/tky0065/go-starter-kit/pkg/generator"
+)
+
+// addModelCommand scaffolds a CRUD resource into an existing project
+var addModelCommand = &Command{
+	Name:        "add-model",
+	Usage:       `add-model <Name> --fields "title:string,published:bool"`,
+	Description: "Add a CRUD resource (model, repository, service, handler, routes) to the project",
+}
+
+// Run is set in init as runAddModel refers to addModelCommand for its usage
+func init() {
+	addModelCommand.Run = runAddModel
+}
+
+// runAddModel parses the add-model arguments, then adds the model to the
+// project. Flags are accepted before and after the model name.
+func runAddModel(args []string) error {
+	flags := flag.NewFlagSet("add-model", flag.ContinueOnError)
+	fields := flags.String("fields", "", "Comma-separated name:type fields (types: "+strings.Join(generator.ModelFieldTypeNames(), ", ")+
+		") and name:relation:Model relations ("+strings.Join(generator.RelationKinds, ", ")+")")
+	dir := flags.String("dir", ".", "Directory of the project to add the model to")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: create-go-starter %s\n\n", addModelCommand.Usage)
+		fmt.Fprintf(flags.Output(), "Run inside a project generated with the full template (or the users feature).\n\nOptions:\n")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return fmt.Errorf("model name is required")
+	}
+	name := flags.Arg(0)
+	if err := flags.Parse(flags.Args()[1:]); err != nil {
+		return err
+	}
+	if flags.NArg() > 0 {
+		return fmt.Errorf("unexpected argument '%s'", flags.Arg(0))
+	}
+
+	m, err := generator.ParseModel(name, *fields)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(Green(fmt.Sprintf("Adding model: %s (/api/v1/%s)", m.Name, m.Path)))
+	created, updated, err := generator.AddModel(*dir, m)
+	if err != nil {
+		return err
+	}
+	for _, p := range created {
+		fmt.Println(Green("✅ Created " + p))
+	}
+	for _, p := range updated {
+		fmt.Println(Green("✅ Updated " + p))
+	}
+
+	fmt.Println()
+	fmt.Println("📋 Next steps:")
+	fmt.Println("  go test ./internal/domain/" + m.Package + "/...")
+	fmt.Println("  make swagger   # Document the new endpoints")
+	return nil
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/tky0065/go-starter-kit/pkg/generator"
+)
+
+// TestAddModelCommand tests add-model through the CLI binary
+func TestAddModelCommand(t *testing.T) {
+	projectPath := writeTestProject(t, testProjectOptions("cli-blog", generator.TemplateFull))
+
+	cmd := exec.Command(binaryPath, "add-model", "Comment", "--dir", projectPath, "--fields", "body:text,approved:bool")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("Expected successful execution, got error: %v\nOutput: %s", err, output)
+	}
+	for _, want := range []string{"Created internal/domain/comment/service.go", "Updated cmd/main.go", "go test ./internal/domain/comment/..."} {
+		if !strings.Contains(string(output), want) {
+			t.Errorf("output should contain %q, got:\n%s", want, output)
+		}
+	}
+
+	cmd = exec.Command(binaryPath, "add-model", "Comment", "--dir", projectPath, "--fields", "body:blob")
+	output, err = cmd.CombinedOutput()
+	if err == nil {
+		t.Fatal("Expected error for an unknown field type")
+	}
+	if !strings.Contains(string(output), "unknown type 'blob'") {
+		t.Errorf("Expected unknown type error, got: %s", output)
+	}
+}
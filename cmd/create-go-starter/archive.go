@@ -0,0 +1,173 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/tky0065/go-starter-kit/pkg/generator"
+)
+
+// archiveStdout is the --archive value writing the archive to stdout
+const archiveStdout = "-"
+
+// archiveFormat returns the format of the archive written to dest: format
+// when set with --archive-format, else the format matching the extension of
+// dest. An archive written to stdout defaults to tar.gz.
+func archiveFormat(dest, format string) (string, error) {
+	if format != "" {
+		if !slices.Contains(generator.ValidArchiveFormats, format) {
+			return "", fmt.Errorf("invalid archive format '%s': valid options are: %s", format, strings.Join(generator.ValidArchiveFormats, ", "))
+		}
+		return format, nil
+	}
+	if dest == archiveStdout {
+		return generator.ArchiveTarGz, nil
+	}
+	return generator.ArchiveFormatOf(dest)
+}
+
+// archiveProject generates the project as an archive written to dest, or to
+// stdout when dest is "-", printing its progress to w. Every entry is under a
+// directory named after the project. The archive holds the same files as a
+// project generated on disk; when opts asks for a git repository or
+// verification, the project is generated in a temporary directory first so
+// that the archive includes .git. A failure leaves no archive behind.
+func archiveProject(w io.Writer, opts generator.ProjectOptions, dest, format string) (*generationReport, error) {
+	report := newGenerationReport(opts)
+	printProjectHeader(w, opts)
+
+	if dest != archiveStdout {
+		if _, err := os.Stat(dest); err == nil {
+			return report, classify(failureDirectoryExists, fmt.Errorf("file %s already exists. Please choose a different name or remove the existing file", dest))
+		}
+	}
+
+	err := runInterruptible(func(ctx context.Context) error {
+		out, commit, err := createArchiveFile(dest)
+		if err != nil {
+			return classify(failureWrite, err)
+		}
+		defer commit(false)
+
+		sink, err := generator.NewArchiveSink(out, format, opts.ProjectName)
+		if err != nil {
+			return classify(failureValidation, err)
+		}
+		if err := writeArchive(ctx, w, report, opts, recordingSink{sink, report}); err != nil {
+			return err
+		}
+		if err := sink.Close(); err != nil {
+			return classify(failureWrite, fmt.Errorf("failed to complete the archive: %w", err))
+		}
+		return classify(failureWrite, commit(true))
+	})
+	if err != nil {
+		report.Directories, report.Files = []string{}, []generatedEntry{}
+		return report, err
+	}
+	report.Project = dest
+
+	if err := checkVerification(w, report, opts.ProjectName); err != nil {
+		return report, err
+	}
+
+	report.OK = true
+	report.NextSteps = nextSteps(opts.ProjectName)
+	if extract := extractCommand(dest, format); extract != "" {
+		report.NextSteps = append([]string{extract}, report.NextSteps...)
+	}
+
+	fmt.Fprintf(w, "\n%s\n", Green(fmt.Sprintf("🎉 Project '%s' archived to %s", opts.ProjectName, archiveName(dest))))
+	fmt.Fprintln(w, "📋 Next steps:")
+	for _, step := range report.NextSteps {
+		fmt.Fprintln(w, "  "+step)
+	}
+	return report, nil
+}
+
+// writeArchive renders the project into sink. A project with a git
+// repository, verification or post hooks is generated in a temporary
+// directory, then copied to sink.
+func writeArchive(ctx context.Context, w io.Writer, report *generationReport, opts generator.ProjectOptions, sink generator.Sink) error {
+	progress := report.recordWarnings(printProgress(w))
+	if !opts.Git.Init && !opts.Verify && len(generator.ResolveHooks(opts).Post) == 0 {
+		return classifyGeneration(generator.Generate(ctx, opts, sink, progress))
+	}
+
+	tmp, err := os.MkdirTemp("", "go-starter-archive-")
+	if err != nil {
+		return classify(failureWrite, fmt.Errorf("failed to create temporary directory: %w", err))
+	}
+	defer os.RemoveAll(tmp)
+	projectPath := filepath.Join(tmp, opts.ProjectName)
+
+	if err := generator.Create(ctx, opts, projectPath, progress); err != nil {
+		return classifyGeneration(err)
+	}
+	if opts.Verify {
+		fmt.Fprintln(w, "🔍 Verifying the generated project...")
+		report.Verification = verifyProject(w, projectPath, verifyStages(opts.Template))
+	}
+	initProjectRepo(w, report, opts, projectPath)
+
+	fmt.Fprintln(w, "📦 Writing the archive...")
+	return classifyGeneration(generator.WriteDir(ctx, sink, projectPath))
+}
+
+// createArchiveFile opens the file the archive is written to: a temporary
+// file next to dest, or stdout. commit(true) moves the temporary file to dest;
+// commit(false) removes it, and does nothing once committed.
+func createArchiveFile(dest string) (out io.Writer, commit func(ok bool) error, err error) {
+	if dest == archiveStdout {
+		return os.Stdout, func(bool) error { return nil }, nil
+	}
+	f, err := os.CreateTemp(filepath.Dir(dest), "."+filepath.Base(dest)+".tmp-")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create archive %s: %w", dest, err)
+	}
+	done := false
+	commit = func(ok bool) error {
+		if done {
+			return nil
+		}
+		done = true
+		if !ok {
+			f.Close()
+			os.Remove(f.Name())
+			return nil
+		}
+		err := errors.Join(f.Close(), os.Chmod(f.Name(), 0644), os.Rename(f.Name(), dest))
+		if err != nil {
+			os.Remove(f.Name())
+			return fmt.Errorf("failed to write archive %s: %w", dest, err)
+		}
+		return nil
+	}
+	return f, commit, nil
+}
+
+// extractCommand returns the command extracting the archive dest, or "" for
+// an archive written to stdout
+func extractCommand(dest, format string) string {
+	switch {
+	case dest == archiveStdout:
+		return ""
+	case format == generator.ArchiveZip:
+		return "unzip " + dest
+	}
+	return "tar -xzf " + dest
+}
+
+// archiveName returns dest for display
+func archiveName(dest string) string {
+	if dest == archiveStdout {
+		return "stdout"
+	}
+	return dest
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/tky0065/go-starter-kit/pkg/generator"
+)
+
+func TestArchiveFormat(t *testing.T) {
+	tests := []struct {
+		dest, format, want string
+		wantErr            bool
+	}{
+		{"app.tar.gz", "", generator.ArchiveTarGz, false},
+		{"app.zip", "", generator.ArchiveZip, false},
+		{"app.out", generator.ArchiveZip, generator.ArchiveZip, false},
+		{archiveStdout, "", generator.ArchiveTarGz, false},
+		{"app.rar", "", "", true},
+		{"app.tar.gz", "rar", "", true},
+	}
+	for _, tt := range tests {
+		got, err := archiveFormat(tt.dest, tt.format)
+		if got != tt.want || (err != nil) != tt.wantErr {
+			t.Errorf("archiveFormat(%q, %q) = %q, %v, want %q (error: %v)", tt.dest, tt.format, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+// readTarGz returns the mode of every regular file of a .tar.gz archive
+func readTarGz(t *testing.T, r io.Reader) map[string]int64 {
+	t.Helper()
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	modes := make(map[string]int64)
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return modes
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if header.Typeflag == tar.TypeReg {
+			modes[header.Name] = header.Mode
+		}
+	}
+}
+
+func TestArchiveProject(t *testing.T) {
+	t.Chdir(t.TempDir())
+	opts := testProjectOptions("archived-app", generator.TemplateMinimal)
+	opts.Git.Init = false
+
+	report, err := archiveProject(io.Discard, opts, "archived-app.tar.gz", generator.ArchiveTarGz)
+	if err != nil {
+		t.Fatalf("archiveProject() error = %v", err)
+	}
+	if !report.OK || report.Project != "archived-app.tar.gz" || report.NextSteps[0] != "tar -xzf archived-app.tar.gz" {
+		t.Errorf("report = %+v", report)
+	}
+	if _, err := os.Stat("archived-app"); !os.IsNotExist(err) {
+		t.Error("archiveProject() should not write the project directory")
+	}
+
+	f, err := os.Open("archived-app.tar.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	modes := readTarGz(t, f)
+	if modes["archived-app/setup.sh"] != 0755 || modes["archived-app/go.mod"] != 0644 {
+		t.Errorf("setup.sh mode = %o, go.mod mode = %o", modes["archived-app/setup.sh"], modes["archived-app/go.mod"])
+	}
+	if _, ok := modes["archived-app/.env"]; !ok {
+		t.Error("the archive should hold the .env copied after generation")
+	}
+	if len(modes) != len(report.Files) {
+		t.Errorf("the archive holds %d files, the report lists %d", len(modes), len(report.Files))
+	}
+
+	// An existing archive is not overwritten
+	_, err = archiveProject(io.Discard, opts, "archived-app.tar.gz", generator.ArchiveTarGz)
+	if failureOf(err) != failureDirectoryExists {
+		t.Errorf("second archiveProject() error = %v, want directory_exists", err)
+	}
+}
+
+// TestArchiveFlag tests --archive with a git repository through the CLI binary
+func TestArchiveFlag(t *testing.T) {
+	if !generator.IsGitAvailable() {
+		t.Skip("git is not installed")
+	}
+	binary, err := filepath.Abs(binaryPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command(binary, "--template=minimal", "--archive=-", "test-archive")
+	cmd.Dir = t.TempDir()
+	out, err := cmd.Output()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == failureGit.ExitCode {
+		t.Skip("git repository not initialized in this environment")
+	}
+	if err != nil {
+		t.Fatalf("--archive=- error = %v", err)
+	}
+
+	entries, err := os.ReadDir(cmd.Dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("--archive=- should not write to the working directory, found %s", entries[0].Name())
+	}
+
+	f, err := os.CreateTemp(t.TempDir(), "archive")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := f.Write(out); err != nil {
+		t.Fatal(err)
+	}
+	f.Seek(0, io.SeekStart)
+	modes := readTarGz(t, f)
+	if _, ok := modes["test-archive/.git/HEAD"]; !ok {
+		names := make([]string, 0, len(modes))
+		for name := range modes {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+		t.Errorf("the archive should hold the git repository, got %v", names)
+	}
+}
@@ -18,4 +18,10 @@ func TestColors(t *testing.T) {
 	if got := Red(msg); got != expectedRed {
 		t.Errorf("Red() = %q, want %q", got, expectedRed)
 	}
+
+	// Test Cyan
+	expectedCyan := "\033[36mtest\033[0m"
+	if got := Cyan(msg); got != expectedCyan {
+		t.Errorf("Cyan() = %q, want %q", got, expectedCyan)
+	}
 }
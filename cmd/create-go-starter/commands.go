@@ -0,0 +1,33 @@
+package main
+
+// Command is a subcommand run inside an existing project
+// (create-go-starter <name> [options]), as opposed to the default command
+// creating a new one
+type Command struct {
+	// Name is the first argument selecting the command
+	Name string
+	// Usage is the argument synopsis shown in the help output
+	Usage string
+	// Description is the one-line summary shown in the help output
+	Description string
+	// Run executes the command with the arguments following its name
+	Run func(args []string) error
+}
+
+// commands lists the subcommands, in help order
+var commands = []*Command{
+	addModelCommand,
+	upgradeCommand,
+	doctorCommand,
+	diffCommand,
+}
+
+// lookupCommand returns the subcommand with the given name
+func lookupCommand(name string) (*Command, bool) {
+	for _, cmd := range commands {
+		if cmd.Name == name {
+			return cmd, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tky0065/go-starter-kit/pkg/generator"
+)
+
+// TestConfigFlag tests --config through the CLI binary, with flags overriding the file
+func TestConfigFlag(t *testing.T) {
+	testProjectName := "test-config-flag"
+	defer os.RemoveAll(testProjectName)
+
+	configPath := filepath.Join(t.TempDir(), "project.yaml")
+	config := `name: ignored-by-argument
+template: full
+module: github.com/our-org/test-config-flag
+ci: none
+git:
+  init: false
+license: MIT
+`
+	if err := os.WriteFile(configPath, []byte(config), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command(binaryPath, "--config", configPath, "--template", "minimal", testProjectName)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("Expected successful execution, got error: %v\nOutput: %s", err, string(output))
+	}
+
+	goMod, err := os.ReadFile(filepath.Join(testProjectName, "go.mod"))
+	if err != nil {
+		t.Fatalf("Failed to read go.mod: %v", err)
+	}
+	if !strings.Contains(string(goMod), "module github.com/our-org/test-config-flag\n") {
+		t.Errorf("go.mod should use the module from the config file, got:\n%s", goMod)
+	}
+
+	// --template overrides the file: the minimal template has no auth package
+	if _, err := os.Stat(filepath.Join(testProjectName, "pkg", "auth")); !os.IsNotExist(err) {
+		t.Error("--template should override the template from the config file")
+	}
+	if _, err := os.Stat(filepath.Join(testProjectName, ".github", "workflows", "ci.yml")); !os.IsNotExist(err) {
+		t.Error("ci: none should not generate a GitHub Actions workflow")
+	}
+	if _, err := os.Stat(filepath.Join(testProjectName, ".git")); !os.IsNotExist(err) {
+		t.Error("git.init: false should not initialize a repository")
+	}
+
+	license, err := os.ReadFile(filepath.Join(testProjectName, "LICENSE"))
+	if err != nil {
+		t.Fatalf("license: MIT should generate a LICENSE file: %v", err)
+	}
+	if !strings.Contains(string(license), "MIT License") {
+		t.Errorf("LICENSE should contain the MIT license, got:\n%s", license)
+	}
+
+	spec, err := generator.LoadProjectConfig(filepath.Join(testProjectName, ".go-starter", "project.yaml"))
+	if err != nil {
+		t.Fatalf("generated project spec should be loadable: %v", err)
+	}
+	if spec.ProjectName != testProjectName || spec.Template != generator.TemplateMinimal || spec.License != "MIT" {
+		t.Errorf("generated project spec = %+v", spec)
+	}
+}
+
+// TestConfigFlagProvidesName tests that the project name can come from the config file
+func TestConfigFlagProvidesName(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "project.json")
+	if err := os.WriteFile(configPath, []byte(`{"name": "test-config-name", "template": "nope"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command(binaryPath, "--config", configPath)
+	output, err := cmd.CombinedOutput()
+	if err == nil {
+		os.RemoveAll("test-config-name")
+		t.Fatal("Expected error for the invalid template in the config file")
+	}
+	if strings.Contains(string(output), "Project name is required") {
+		t.Errorf("project name should be read from the config file, got: %s", output)
+	}
+	if !strings.Contains(string(output), "invalid template") {
+		t.Errorf("Expected 'invalid template' error, got: %s", output)
+	}
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestDatabaseFlag tests --database through the CLI binary
+func TestDatabaseFlag(t *testing.T) {
+	testProjectName := "test-database-flag"
+	defer os.RemoveAll(testProjectName)
+
+	cmd := exec.Command(binaryPath, "--template=minimal", "--database=sqlite", testProjectName)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("Expected successful execution, got error: %v\nOutput: %s", err, string(output))
+	}
+	if strings.Contains(string(output), "MUST be started") {
+		t.Errorf("SQLite projects need no database server, got: %s", string(output))
+	}
+
+	goMod, err := os.ReadFile(filepath.Join(testProjectName, "go.mod"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(goMod), "github.com/glebarez/sqlite") {
+		t.Errorf("--database=sqlite should require the SQLite driver, got:\n%s", goMod)
+	}
+}
+
+// TestInvalidDatabaseFlag tests that unsupported databases are rejected
+func TestInvalidDatabaseFlag(t *testing.T) {
+	cmd := exec.Command(binaryPath, "--database=oracle", "test-invalid-database")
+	output, err := cmd.CombinedOutput()
+	if err == nil {
+		os.RemoveAll("test-invalid-database")
+		t.Fatal("Expected error for --database=oracle")
+	}
+	if !strings.Contains(string(output), "invalid database 'oracle': valid options are: postgres, mysql, sqlite") {
+		t.Errorf("Expected invalid database error, got: %s", string(output))
+	}
+}
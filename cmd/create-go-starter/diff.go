@@ -0,0 +1,168 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/tky0065/go-starter-kit/pkg/generator"
+)
+
+// diffCommand compares a project with its template, as generated or re-rendered
+// in memory
+var diffCommand = &Command{
+	Name:        "diff",
+	Usage:       "diff [--dir <project>] [--rendered] [--stat] [--json] [--exit-code] [--template-dir <pack>] [path...]",
+	Description: "Show how the project drifted from its template: modified, deleted and untouched files",
+}
+
+// Run is set in init as runDiff refers to diffCommand for its usage
+func init() {
+	diffCommand.Run = runDiff
+}
+
+// runDiff parses the diff arguments, then compares the project with its
+// template and prints the unified diff of every modified file followed by a
+// summary. Paths restrict the comparison to these files and directories.
+func runDiff(args []string) error {
+	flags := flag.NewFlagSet("diff", flag.ContinueOnError)
+	dir := flags.String("dir", ".", "Directory of the project to compare")
+	rendered := flags.Bool("rendered", false, "Compare with the current templates rendered in memory instead of the files as generated")
+	stat := flags.Bool("stat", false, "List the files without their diffs")
+	jsonOutput := flags.Bool("json", false, "Print the comparison as JSON")
+	exitCode := flags.Bool("exit-code", false, "Fail when a generated file was modified or deleted")
+	templateDir := flags.String("template-dir", "", "Template pack the project was generated from, if not a built-in template")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: create-go-starter %s\n\n", diffCommand.Usage)
+		fmt.Fprintf(flags.Output(), "Run inside a project generated by create-go-starter. Paths are relative to the\nproject root.\n\nOptions:\n")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	paths := make([]string, flags.NArg())
+	for i, p := range flags.Args() {
+		paths[i] = path.Clean(filepath.ToSlash(p))
+	}
+
+	if *templateDir != "" {
+		if _, err := registerTemplateDir(*templateDir); err != nil {
+			return err
+		}
+	}
+
+	result, err := generator.Drift(*dir, *rendered)
+	if err != nil {
+		return err
+	}
+	result.Files = filterDrift(result.Files, paths)
+
+	if *jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(result); err != nil {
+			return err
+		}
+	} else {
+		printDrift(result, *stat)
+	}
+
+	if *exitCode && result.Drifted() {
+		return fmt.Errorf("%d file(s) drifted from template %s", result.Count(generator.DriftModified)+result.Count(generator.DriftDeleted), result.Template)
+	}
+	return nil
+}
+
+// filterDrift returns the files of drift that are in paths or in a directory
+// of paths, or every file if paths is empty
+func filterDrift(files []generator.FileDrift, paths []string) []generator.FileDrift {
+	if len(paths) == 0 {
+		return files
+	}
+	var filtered []generator.FileDrift
+	for _, f := range files {
+		for _, p := range paths {
+			if p == "." || f.Path == p || strings.HasPrefix(f.Path, p+"/") {
+				filtered = append(filtered, f)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// printDrift prints the diffs of the modified files, unless stat is set, then
+// the status of every file that is not untouched and the totals
+func printDrift(result *generator.DriftResult, stat bool) {
+	switch {
+	case result.Baseline == generator.DriftBaselineGenerated:
+		fmt.Println(Green(fmt.Sprintf("🔍 Comparing with template %s as generated (templates %s, create-go-starter %s)",
+			result.Template, result.FromTemplates, result.FromVersion)))
+		if result.TemplatesChanged {
+			fmt.Printf("ℹ️  The templates changed since generation (now %s): run create-go-starter upgrade to get the\n   changes, or diff --rendered to compare with them.\n",
+				generator.TemplatesVersion())
+		}
+	default:
+		fmt.Println(Green(fmt.Sprintf("🔍 Comparing with template %s rendered by templates %s (create-go-starter %s)",
+			result.Template, generator.TemplatesVersion(), generator.Version)))
+		if result.StaleArchive {
+			fmt.Println("⚠️  .go-starter/base.tar.gz does not match the manifest: the files as generated are unknown.")
+		}
+		if result.TemplatesChanged {
+			fmt.Printf("⚠️  The project was generated from templates %s (create-go-starter %s): changes made to the\n   templates since then show up as drift.\n",
+				result.FromTemplates, result.FromVersion)
+		}
+	}
+
+	if !stat {
+		for _, f := range result.Files {
+			if f.Status == generator.DriftModified {
+				fmt.Print(colorizeDiff(f.Diff))
+			}
+		}
+	}
+
+	fmt.Println()
+	for _, f := range result.Files {
+		switch f.Status {
+		case generator.DriftModified:
+			fmt.Println(Red("✏️  Modified " + f.Path))
+		case generator.DriftDeleted:
+			fmt.Println(Red("🗑️  Deleted " + f.Path))
+		case generator.DriftNew:
+			fmt.Println("⏭️  Not generated " + f.Path + " (new in the templates)")
+		}
+	}
+	fmt.Printf("%d modified, %d deleted, %d untouched\n", result.Count(generator.DriftModified),
+		result.Count(generator.DriftDeleted), result.Count(generator.DriftUntouched))
+	if !result.Drifted() {
+		fmt.Println(Green("✅ The project matches its template"))
+	}
+}
+
+// colorizeDiff wraps added lines in green, removed lines in red and hunk
+// headers in cyan.
+func colorizeDiff(diff string) string {
+	var out strings.Builder
+	for line := range strings.Lines(diff) {
+		text := strings.TrimSuffix(line, "\n")
+		switch {
+		case strings.HasPrefix(text, "+++"), strings.HasPrefix(text, "---"):
+			out.WriteString(line)
+		case strings.HasPrefix(text, "@@"):
+			out.WriteString(Cyan(text) + "\n")
+		case strings.HasPrefix(text, "+"):
+			out.WriteString(Green(text) + "\n")
+		case strings.HasPrefix(text, "-"):
+			out.WriteString(Red(text) + "\n")
+		default:
+			out.WriteString(line)
+		}
+	}
+	return out.String()
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tky0065/go-starter-kit/pkg/generator"
+)
+
+func TestColorizeDiff(t *testing.T) {
+	colored := colorizeDiff("--- a\n+++ b\n@@ -1 +1 @@\n-old\n+new\n same\n")
+	for _, want := range []string{"--- a\n", "+++ b\n", Cyan("@@ -1 +1 @@"), Red("-old"), Green("+new"), " same\n"} {
+		if !strings.Contains(colored, want) {
+			t.Errorf("colorizeDiff() output should contain %q, got: %q", want, colored)
+		}
+	}
+}
+
+func TestFilterDrift(t *testing.T) {
+	files := []generator.FileDrift{{Path: "Dockerfile"}, {Path: "cmd/main.go"}, {Path: "cmdline.txt"}, {Path: "go.mod"}}
+	got := filterDrift(files, []string{"Dockerfile", "cmd"})
+	if want := []generator.FileDrift{{Path: "Dockerfile"}, {Path: "cmd/main.go"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("filterDrift() = %v, want %v", got, want)
+	}
+	if got := filterDrift(files, nil); len(got) != len(files) {
+		t.Errorf("filterDrift() without paths = %v, want every file", got)
+	}
+}
+
+// TestDiffCommand tests diff through the CLI binary
+func TestDiffCommand(t *testing.T) {
+	projectPath := writeTestProject(t, testProjectOptions("cli-diff", generator.TemplateMinimal))
+
+	output, err := exec.Command(binaryPath, "diff", "--dir", projectPath, "--exit-code").CombinedOutput()
+	if err != nil || !strings.Contains(string(output), "matches its template") {
+		t.Errorf("diff of an untouched project = %v, output:\n%s", err, output)
+	}
+
+	dockerfile := filepath.Join(projectPath, "Dockerfile")
+	content, err := os.ReadFile(dockerfile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(dockerfile, []byte(strings.Replace(string(content), "USER appuser\n", "", 1)), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	output, err = exec.Command(binaryPath, "diff", "--dir", projectPath).CombinedOutput()
+	if err != nil {
+		t.Fatalf("diff error = %v, output:\n%s", err, output)
+	}
+	for _, want := range []string{"--- template/Dockerfile", "-USER appuser", "Modified Dockerfile", "1 modified, 0 deleted"} {
+		if !strings.Contains(string(output), want) {
+			t.Errorf("diff output should contain %q, got:\n%s", want, output)
+		}
+	}
+
+	output, err = exec.Command(binaryPath, "diff", "--dir", projectPath, "--json", "--exit-code", "Dockerfile").Output()
+	if err == nil {
+		t.Error("diff --exit-code should fail when a file was modified")
+	}
+	var result generator.DriftResult
+	if err := json.Unmarshal(output, &result); err != nil {
+		t.Fatalf("output is not JSON: %v\n%s", err, output)
+	}
+	if len(result.Files) != 1 || result.Files[0].Status != generator.DriftModified {
+		t.Errorf("diff --json Dockerfile = %+v", result)
+	}
+	if result.Baseline != generator.DriftBaselineGenerated {
+		t.Errorf("diff baseline = %q, want the files as generated", result.Baseline)
+	}
+
+	output, err = exec.Command(binaryPath, "diff", "--dir", projectPath, "--rendered", "--json").Output()
+	if err != nil {
+		t.Fatalf("diff --rendered error = %v", err)
+	}
+	result = generator.DriftResult{}
+	if err := json.Unmarshal(output, &result); err != nil {
+		t.Fatalf("output is not JSON: %v\n%s", err, output)
+	}
+	if result.Baseline != generator.DriftBaselineRendered || result.Count(generator.DriftModified) != 1 {
+		t.Errorf("diff --rendered = %+v, want the Dockerfile modified against a fresh render", result)
+	}
+}
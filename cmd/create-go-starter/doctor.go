@@ -0,0 +1,454 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"go/version"
+	"io/fs"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/tky0065/go-starter-kit/pkg/generator"
+	"golang.org/x/mod/modfile"
+)
+
+// doctorCommand checks that a generated project can be built and run on this machine
+var doctorCommand = &Command{
+	Name:        "doctor",
+	Usage:       "doctor [--dir <project>] [--json]",
+	Description: "Check the project environment: Go, tools, .env, database and JWT secret",
+}
+
+// Run is set in init as runDoctor refers to doctorCommand for its usage
+func init() {
+	doctorCommand.Run = runDoctor
+}
+
+// Status of a doctor check
+const (
+	checkPass = "pass"
+	checkFail = "fail"
+	checkSkip = "skip"
+)
+
+// doctorDialTimeout bounds the database reachability check
+const doctorDialTimeout = 2 * time.Second
+
+// minJWTSecretLength is the length below which JWT_SECRET is reported as weak.
+// openssl rand -base64 32, suggested by the generated project, gives 44 characters.
+const minJWTSecretLength = 32
+
+// doctorTools maps the commands run by the generated Makefiles to their install hint
+var doctorTools = map[string]string{
+	"air":           "go install github.com/air-verse/air@latest",
+	"swag":          "go install github.com/swaggo/swag/cmd/swag@latest",
+	"golangci-lint": "go install github.com/golangci/golangci-lint/cmd/golangci-lint@latest",
+	"docker":        "Install Docker: https://docs.docker.com/get-docker/",
+}
+
+// doctorCheck is the outcome of a single doctor check
+type doctorCheck struct {
+	// Name identifies what was checked
+	Name string `json:"name"`
+	// Status is pass, fail or skip (the check does not apply to the project)
+	Status string `json:"status"`
+	// Message describes the outcome
+	Message string `json:"message"`
+	// Hint tells how to fix a failed check
+	Hint string `json:"hint,omitempty"`
+}
+
+// doctorReport is the result of the doctor command, also printed by --json
+type doctorReport struct {
+	// Project is the checked project directory
+	Project string `json:"project"`
+	// OK reports whether every check passed or was skipped
+	OK bool `json:"ok"`
+	// Checks lists the checks in the order they ran
+	Checks []doctorCheck `json:"checks"`
+}
+
+// failed returns the number of failed checks
+func (r *doctorReport) failed() int {
+	n := 0
+	for _, c := range r.Checks {
+		if c.Status == checkFail {
+			n++
+		}
+	}
+	return n
+}
+
+// runDoctor parses the doctor arguments, checks the project and prints the
+// report. It fails when a check fails, so it can gate scripts.
+func runDoctor(args []string) error {
+	flags := flag.NewFlagSet("doctor", flag.ContinueOnError)
+	dir := flags.String("dir", ".", "Directory of the project to check")
+	jsonOutput := flags.Bool("json", false, "Print the report as JSON")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: create-go-starter %s\n\n", doctorCommand.Usage)
+		fmt.Fprintf(flags.Output(), "Run inside a project generated by create-go-starter.\n\nOptions:\n")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() > 0 {
+		return fmt.Errorf("unexpected argument '%s'", flags.Arg(0))
+	}
+
+	report, err := diagnoseProject(*dir)
+	if err != nil {
+		return err
+	}
+
+	if *jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			return err
+		}
+	} else {
+		fmt.Println(Green("🩺 Checking " + report.Project))
+		for _, c := range report.Checks {
+			switch c.Status {
+			case checkPass:
+				fmt.Println(Green("✅ " + c.Name + ": " + c.Message))
+			case checkSkip:
+				fmt.Println("⏭️  " + c.Name + ": " + c.Message)
+			default:
+				fmt.Println(Red("❌ " + c.Name + ": " + c.Message))
+				fmt.Println("   → " + c.Hint)
+			}
+		}
+	}
+
+	if n := report.failed(); n > 0 {
+		return fmt.Errorf("%d check(s) failed", n)
+	}
+	if !*jsonOutput {
+		fmt.Println(Green("✅ Everything looks good"))
+	}
+	return nil
+}
+
+// diagnoseProject runs every check against the project in projectPath
+func diagnoseProject(projectPath string) (*doctorReport, error) {
+	goMod, err := os.ReadFile(filepath.Join(projectPath, "go.mod"))
+	if err != nil {
+		return nil, fmt.Errorf("no go.mod found in %s: run doctor inside a project generated by create-go-starter", projectPath)
+	}
+	env, err := loadDoctorEnv(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	manifestCheck, manifest := checkManifest(projectPath)
+	checks := []doctorCheck{manifestCheck, checkGoToolchain(goMod), checkGoSum(projectPath)}
+	toolChecks, err := checkMakefileTools(projectPath)
+	if err != nil {
+		return nil, err
+	}
+	checks = append(checks, toolChecks...)
+	database := ""
+	if manifest != nil {
+		database = manifest.Options.Database
+	}
+	checks = append(checks, env.checkKeys(), env.checkJWTSecret(), env.checkDatabase(database))
+
+	report := &doctorReport{Project: projectPath, Checks: checks}
+	report.OK = report.failed() == 0
+	return report, nil
+}
+
+// checkManifest reads the manifest recording how the project was generated. It
+// returns nil as the manifest when the project has none or it is invalid.
+func checkManifest(projectPath string) (doctorCheck, *generator.Manifest) {
+	const name = "Manifest"
+	m, err := generator.LoadManifest(projectPath)
+	if errors.Is(err, generator.ErrNoManifest) {
+		return doctorCheck{name, checkSkip, "no " + generator.ManifestPath + ": generated by an older create-go-starter", ""}, nil
+	}
+	if err != nil {
+		return doctorCheck{name, checkFail, err.Error(), "Restore it with git checkout -- " + generator.ManifestPath}, nil
+	}
+	message := fmt.Sprintf("template %s, module %s, generated by create-go-starter %s", m.Template, m.ModulePath, m.GeneratorVersion)
+	if !m.GeneratedAt.IsZero() {
+		message += " on " + m.GeneratedAt.Format(time.DateOnly)
+	}
+	return doctorCheck{name, checkPass, message, ""}, m
+}
+
+// checkGoToolchain compares the installed Go toolchain with the go directive of go.mod
+func checkGoToolchain(goMod []byte) doctorCheck {
+	const name = "Go toolchain"
+	file, err := modfile.ParseLax("go.mod", goMod, nil)
+	if err != nil || file.Go == nil {
+		return doctorCheck{name, checkFail, "go.mod has no go directive", "Add a go directive to go.mod (e.g. go mod edit -go=1.25)"}
+	}
+
+	// GOTOOLCHAIN=local reports the installed toolchain instead of downloading
+	// the one go.mod asks for
+	cmd := exec.Command("go", "env", "GOVERSION")
+	cmd.Env = append(os.Environ(), "GOTOOLCHAIN=local")
+	output, err := cmd.Output()
+	if err != nil {
+		return doctorCheck{name, checkFail, "go not found in PATH", "Install Go " + file.Go.Version + " or later from https://go.dev/dl/"}
+	}
+	return compareGoVersion(strings.TrimSpace(string(output)), file.Go.Version)
+}
+
+// compareGoVersion checks that the local toolchain version (e.g. go1.25.5)
+// satisfies the go directive (e.g. 1.25.5)
+func compareGoVersion(local, directive string) doctorCheck {
+	const name = "Go toolchain"
+	if version.Compare(local, "go"+directive) < 0 {
+		return doctorCheck{name, checkFail, fmt.Sprintf("%s is older than go %s required by go.mod", local, directive),
+			"Install Go " + directive + " or later from https://go.dev/dl/, or set GOTOOLCHAIN=auto to let go download it"}
+	}
+	return doctorCheck{name, checkPass, fmt.Sprintf("%s satisfies go %s", local, directive), ""}
+}
+
+// checkGoSum checks that the module dependencies have been resolved
+func checkGoSum(projectPath string) doctorCheck {
+	const name = "go.sum"
+	if _, err := os.Stat(filepath.Join(projectPath, "go.sum")); err != nil {
+		return doctorCheck{name, checkFail, "missing: dependencies are not resolved", "go mod tidy"}
+	}
+	return doctorCheck{name, checkPass, "dependencies resolved", ""}
+}
+
+// makeTargetPattern matches a Makefile rule, capturing its target, but not a
+// := variable assignment
+var makeTargetPattern = regexp.MustCompile(`^([A-Za-z0-9_.-]+)\s*:([^=]|$)`)
+
+// makeTool is an external command run by Makefile targets
+type makeTool struct {
+	Name    string
+	Targets []string
+}
+
+// makefileTools returns the commands of doctorTools run by the Makefile
+// recipes, in order of first use, with the targets running them
+func makefileTools(makefile []byte) []makeTool {
+	var tools []makeTool
+	target := ""
+	scanner := bufio.NewScanner(bytes.NewReader(makefile))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if m := makeTargetPattern.FindStringSubmatch(line); m != nil {
+			target = m[1]
+			continue
+		}
+		if !strings.HasPrefix(line, "\t") || target == "" {
+			continue
+		}
+		fields := strings.Fields(strings.TrimLeft(line, "\t @-+"))
+		if len(fields) == 0 {
+			continue
+		}
+		if _, known := doctorTools[fields[0]]; !known {
+			continue
+		}
+		i := slices.IndexFunc(tools, func(t makeTool) bool { return t.Name == fields[0] })
+		if i < 0 {
+			tools = append(tools, makeTool{Name: fields[0]})
+			i = len(tools) - 1
+		}
+		if !slices.Contains(tools[i].Targets, target) {
+			tools[i].Targets = append(tools[i].Targets, target)
+		}
+	}
+	return tools
+}
+
+// checkMakefileTools checks that make and the tools used by the Makefile are installed
+func checkMakefileTools(projectPath string) ([]doctorCheck, error) {
+	makefile, err := os.ReadFile(filepath.Join(projectPath, "Makefile"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return []doctorCheck{{"make", checkSkip, "the project has no Makefile", ""}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	checks := []doctorCheck{lookTool("make", "every target", "Install make (e.g. apt install make, xcode-select --install)")}
+	for _, tool := range makefileTools(makefile) {
+		hint := doctorTools[tool.Name]
+		if strings.HasPrefix(hint, "go install") {
+			hint += " (and add $(go env GOPATH)/bin to PATH)"
+		}
+		checks = append(checks, lookTool(tool.Name, "make "+strings.Join(tool.Targets, ", make "), hint))
+	}
+	return checks, nil
+}
+
+// lookTool checks that the named command is in PATH
+func lookTool(name, usedBy, hint string) doctorCheck {
+	path, err := exec.LookPath(name)
+	if err != nil {
+		return doctorCheck{name, checkFail, "not found in PATH (needed by " + usedBy + ")", hint}
+	}
+	return doctorCheck{name, checkPass, path, ""}
+}
+
+// doctorEnv holds the environment files of a project
+type doctorEnv struct {
+	// example and env map the keys of .env.example and .env to their values
+	example, env map[string]string
+	// exampleKeys lists the keys of .env.example in order
+	exampleKeys []string
+	// hasExample and hasEnv report whether the files exist
+	hasExample, hasEnv bool
+}
+
+// loadDoctorEnv reads .env.example and .env in projectPath
+func loadDoctorEnv(projectPath string) (*doctorEnv, error) {
+	e := &doctorEnv{}
+	var err error
+	if e.example, e.exampleKeys, e.hasExample, err = readEnvFile(filepath.Join(projectPath, ".env.example")); err != nil {
+		return nil, err
+	}
+	if e.env, _, e.hasEnv, err = readEnvFile(filepath.Join(projectPath, ".env")); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// readEnvFile reads a KEY=value file, returning its values, its keys in order
+// and whether it exists
+func readEnvFile(path string) (map[string]string, []string, bool, error) {
+	content, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return map[string]string{}, nil, false, nil
+	}
+	if err != nil {
+		return nil, nil, false, err
+	}
+	values, keys := parseEnvFile(string(content))
+	return values, keys, true, nil
+}
+
+// parseEnvFile parses KEY=value lines as godotenv does for the simple cases:
+// comments, blank lines, an export prefix and quoted values
+func parseEnvFile(content string) (map[string]string, []string) {
+	values := map[string]string{}
+	var keys []string
+	for line := range strings.Lines(content) {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(strings.TrimPrefix(line, "export "), "=")
+		if !ok {
+			continue
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+		if _, seen := values[key]; !seen {
+			keys = append(keys, key)
+		}
+		values[key] = value
+	}
+	return values, keys
+}
+
+// declares reports whether the project uses the key
+func (e *doctorEnv) declares(key string) bool {
+	_, inExample := e.example[key]
+	_, inEnv := e.env[key]
+	return inExample || inEnv
+}
+
+// value returns the value the application sees for key: the process
+// environment wins over .env, as with godotenv.Load
+func (e *doctorEnv) value(key string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	if v, ok := e.env[key]; ok {
+		return v
+	}
+	return e.example[key]
+}
+
+// checkKeys checks that .env defines every key of .env.example
+func (e *doctorEnv) checkKeys() doctorCheck {
+	const name = ".env"
+	switch {
+	case !e.hasExample:
+		return doctorCheck{name, checkSkip, "the project has no .env.example", ""}
+	case !e.hasEnv:
+		return doctorCheck{name, checkFail, "missing", "cp .env.example .env (./setup.sh does it for you)"}
+	}
+
+	var missing []string
+	for _, key := range e.exampleKeys {
+		if _, ok := e.env[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		return doctorCheck{name, checkFail, "missing " + strings.Join(missing, ", "), "Copy the missing keys from .env.example into .env"}
+	}
+	return doctorCheck{name, checkPass, fmt.Sprintf("all %d keys of .env.example are set", len(e.exampleKeys)), ""}
+}
+
+// checkJWTSecret checks that JWT_SECRET is set to a strong enough secret
+func (e *doctorEnv) checkJWTSecret() doctorCheck {
+	const name = "JWT_SECRET"
+	const hint = "Generate one with openssl rand -base64 32 and set JWT_SECRET=<secret> in .env"
+	if !e.declares(name) {
+		return doctorCheck{name, checkSkip, "not used by the project", ""}
+	}
+	secret := e.value(name)
+	switch {
+	case secret == "":
+		return doctorCheck{name, checkFail, "empty: the application refuses to start", hint}
+	case len(secret) < minJWTSecretLength:
+		return doctorCheck{name, checkFail, fmt.Sprintf("weak: %d characters, at least %d expected", len(secret), minJWTSecretLength), hint}
+	}
+	return doctorCheck{name, checkPass, fmt.Sprintf("set (%d characters)", len(secret)), ""}
+}
+
+// checkDatabase checks that the database server of DB_HOST:DB_PORT accepts
+// connections. database is the driver recorded in the manifest, or empty when
+// the project has none: the driver is then told from the keys of .env.
+func (e *doctorEnv) checkDatabase(database string) doctorCheck {
+	const name = "Database"
+	if database == "sqlite" {
+		return doctorCheck{name, checkSkip, "SQLite needs no server", ""}
+	}
+	if !e.declares("DB_HOST") {
+		if e.declares("DB_PATH") {
+			return doctorCheck{name, checkSkip, "SQLite needs no server", ""}
+		}
+		return doctorCheck{name, checkSkip, "no DB_HOST configured", ""}
+	}
+
+	host, port := e.value("DB_HOST"), e.value("DB_PORT")
+	if host == "" || port == "" {
+		return doctorCheck{name, checkFail, "DB_HOST or DB_PORT is empty", "Set DB_HOST and DB_PORT in .env (see .env.example)"}
+	}
+	addr := net.JoinHostPort(host, port)
+	conn, err := net.DialTimeout("tcp", addr, doctorDialTimeout)
+	if err != nil {
+		return doctorCheck{name, checkFail, fmt.Sprintf("cannot reach %s: %v", addr, err),
+			"Start the database (docker compose up -d db, or ./setup.sh) or fix DB_HOST/DB_PORT in .env"}
+	}
+	conn.Close()
+	return doctorCheck{name, checkPass, addr + " is reachable", ""}
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"encoding/json"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tky0065/go-starter-kit/pkg/generator"
+)
+
+func TestCompareGoVersion(t *testing.T) {
+	tests := []struct {
+		local, directive, want string
+	}{
+		{"go1.25.5", "1.25.5", checkPass},
+		{"go1.26.0", "1.25.5", checkPass},
+		{"go1.25.0", "1.25", checkPass},
+		{"go1.25.4", "1.25.5", checkFail},
+		{"go1.24.9", "1.25", checkFail},
+	}
+	for _, tt := range tests {
+		if got := compareGoVersion(tt.local, tt.directive); got.Status != tt.want {
+			t.Errorf("compareGoVersion(%q, %q) = %+v, want %s", tt.local, tt.directive, got, tt.want)
+		}
+	}
+}
+
+func TestMakefileTools(t *testing.T) {
+	makefile := "BINARY_NAME=app\nGOBIN := bin\n\n.PHONY: dev lint\n\ndev: ## Hot reload\n\t@echo \"dev\"\n\t@air\n\n" +
+		"lint:\n\t-golangci-lint run ./...\n\ndocker-build:\n\t@docker build .\n\ndocker-run: docker-build\n\t@docker run app\n"
+	want := []makeTool{
+		{Name: "air", Targets: []string{"dev"}},
+		{Name: "golangci-lint", Targets: []string{"lint"}},
+		{Name: "docker", Targets: []string{"docker-build", "docker-run"}},
+	}
+	if got := makefileTools([]byte(makefile)); !reflect.DeepEqual(got, want) {
+		t.Errorf("makefileTools() = %+v, want %+v", got, want)
+	}
+}
+
+func TestParseEnvFile(t *testing.T) {
+	values, keys := parseEnvFile("# comment\nAPP_NAME=app\n\nexport DB_HOST = db\nJWT_SECRET=\"a b\"\nNAME='x'\ninvalid line\n")
+	want := map[string]string{"APP_NAME": "app", "DB_HOST": "db", "JWT_SECRET": "a b", "NAME": "x"}
+	if !reflect.DeepEqual(values, want) || !reflect.DeepEqual(keys, []string{"APP_NAME", "DB_HOST", "JWT_SECRET", "NAME"}) {
+		t.Errorf("parseEnvFile() = %v %v", values, keys)
+	}
+}
+
+// findCheck returns the check with the given name in the report
+func findCheck(t *testing.T, report *doctorReport, name string) doctorCheck {
+	t.Helper()
+	for _, c := range report.Checks {
+		if c.Name == name {
+			return c
+		}
+	}
+	t.Fatalf("no %s check in %+v", name, report.Checks)
+	return doctorCheck{}
+}
+
+// TestDiagnoseProject tests the .env, JWT secret, database and go.sum checks
+// on a generated project
+func TestDiagnoseProject(t *testing.T) {
+	projectPath := writeTestProject(t, testProjectOptions("doctor-app", generator.TemplateFull))
+	t.Setenv("JWT_SECRET", "")
+	t.Setenv("DB_HOST", "")
+	t.Setenv("DB_PORT", "")
+
+	report, err := diagnoseProject(projectPath)
+	if err != nil {
+		t.Fatalf("diagnoseProject() error = %v", err)
+	}
+	if report.OK {
+		t.Errorf("report should fail without .env")
+	}
+	for name, status := range map[string]string{"Manifest": checkPass, ".env": checkFail, "JWT_SECRET": checkFail, "go.sum": checkFail, "make": ""} {
+		if c := findCheck(t, report, name); status != "" && c.Status != status {
+			t.Errorf("%s check = %+v, want %s", name, c, status)
+		}
+	}
+	if c := findCheck(t, report, "JWT_SECRET"); c.Hint == "" {
+		t.Errorf("failed checks should have a hint")
+	}
+
+	// A database listening on DB_HOST:DB_PORT, a strong secret and go.sum
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	example, _ := os.ReadFile(filepath.Join(projectPath, ".env.example"))
+	env := strings.NewReplacer("DB_HOST=localhost", "DB_HOST="+host, "DB_PORT=5432", "DB_PORT="+port,
+		"JWT_SECRET=", "JWT_SECRET="+strings.Repeat("s", minJWTSecretLength)).Replace(string(example))
+	if err := os.WriteFile(filepath.Join(projectPath, ".env"), []byte(env), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(projectPath, "go.sum"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	report, err = diagnoseProject(projectPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{".env", "JWT_SECRET", "Database", "go.sum"} {
+		if c := findCheck(t, report, name); c.Status != checkPass {
+			t.Errorf("%s check = %+v, want pass", name, c)
+		}
+	}
+
+	// The process environment wins over .env
+	t.Setenv("JWT_SECRET", "short")
+	report, _ = diagnoseProject(projectPath)
+	if c := findCheck(t, report, "JWT_SECRET"); c.Status != checkFail || !strings.Contains(c.Message, "weak") {
+		t.Errorf("JWT_SECRET check = %+v, want weak", c)
+	}
+
+	// Missing keys are listed
+	if err := os.WriteFile(filepath.Join(projectPath, ".env"), []byte("APP_NAME=x\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	report, _ = diagnoseProject(projectPath)
+	if c := findCheck(t, report, ".env"); c.Status != checkFail || !strings.Contains(c.Message, "DB_HOST") {
+		t.Errorf(".env check = %+v, want missing keys", c)
+	}
+}
+
+// TestDiagnoseSQLiteProject tests that the database check is skipped for SQLite
+func TestDiagnoseSQLiteProject(t *testing.T) {
+	opts := testProjectOptions("doctor-lite", generator.TemplateMinimal)
+	opts.Database = "sqlite"
+	report, err := diagnoseProject(writeTestProject(t, opts))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := findCheck(t, report, "Database"); c.Status != checkSkip {
+		t.Errorf("Database check = %+v, want skip", c)
+	}
+	if c := findCheck(t, report, "JWT_SECRET"); c.Status != checkSkip {
+		t.Errorf("JWT_SECRET check = %+v, want skip without auth", c)
+	}
+	if c := findCheck(t, report, "Manifest"); !strings.Contains(c.Message, "template minimal") {
+		t.Errorf("Manifest check = %+v, want the template", c)
+	}
+}
+
+// TestDoctorCommand tests doctor --json through the CLI binary
+func TestDoctorCommand(t *testing.T) {
+	projectPath := writeTestProject(t, testProjectOptions("cli-doctor", generator.TemplateFull))
+
+	cmd := exec.Command(binaryPath, "doctor", "--dir", projectPath, "--json")
+	cmd.Env = append(os.Environ(), "JWT_SECRET=")
+	output, err := cmd.Output()
+	if err == nil {
+		t.Fatal("Expected doctor to fail without .env")
+	}
+	var report doctorReport
+	if err := json.Unmarshal(output, &report); err != nil {
+		t.Fatalf("output is not a JSON report: %v\n%s", err, output)
+	}
+	if report.OK || report.Project != projectPath || len(report.Checks) == 0 {
+		t.Errorf("report = %+v", report)
+	}
+
+	output, err = exec.Command(binaryPath, "doctor", "--dir", t.TempDir()).CombinedOutput()
+	if err == nil || !strings.Contains(string(output), "no go.mod found") {
+		t.Errorf("doctor outside a project = %v, output:\n%s", err, output)
+	}
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"unicode/utf8"
+
+	"github.com/tky0065/go-starter-kit/pkg/generator"
+)
+
+// Dry-run modes accepted by the --dry-run flag
+const (
+	DryRunOff  = ""
+	DryRunTree = "tree"
+	DryRunDiff = "diff"
+)
+
+// dryRunFlag implements flag.Value for --dry-run. It behaves like a boolean
+// flag (--dry-run) while also accepting a mode (--dry-run=diff).
+type dryRunFlag struct {
+	mode string
+}
+
+func (f *dryRunFlag) String() string {
+	return f.mode
+}
+
+func (f *dryRunFlag) Set(value string) error {
+	switch value {
+	case "true", DryRunTree:
+		f.mode = DryRunTree
+	case "false":
+		f.mode = DryRunOff
+	case DryRunDiff:
+		f.mode = DryRunDiff
+	default:
+		return fmt.Errorf("invalid dry-run mode '%s': valid options are: %s, %s", value, DryRunTree, DryRunDiff)
+	}
+	return nil
+}
+
+// IsBoolFlag lets --dry-run be used without a value
+func (f *dryRunFlag) IsBoolFlag() bool {
+	return true
+}
+
+// previewProject runs the same pipeline as run() without touching disk.
+// It prints the directories and files that would be created and the steps that
+// would run. In DryRunDiff mode, it also prints a colored unified diff of every
+// generated file against the existing project directory.
+func previewProject(w io.Writer, opts generator.ProjectOptions, mode string) error {
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+	projectName, template := opts.ProjectName, opts.Template
+	tmpl, _ := generator.LookupTemplate(template)
+
+	// Use project name as directory path (relative to current directory)
+	projectPath := projectName
+
+	fmt.Fprintln(w, Green(fmt.Sprintf("Dry run: project %s (template: %s) - nothing will be written", projectName, template)))
+
+	_, statErr := os.Stat(projectPath)
+	exists := statErr == nil
+	if exists && mode != DryRunDiff {
+		fmt.Fprintln(w, Red(fmt.Sprintf("⚠️  Directory %s already exists: generation would fail", projectPath)))
+	}
+
+	fmt.Fprintln(w, "\n📁 Directories:")
+	fmt.Fprintf(w, "  %s/\n", projectPath)
+	for _, dir := range tmpl.Directories() {
+		fmt.Fprintf(w, "  %s/\n", filepath.Join(projectPath, dir))
+	}
+
+	files, err := generator.PreviewProjectFiles(projectPath, opts)
+	if err != nil {
+		return err
+	}
+	for i := range files {
+		files[i].Path = filepath.Join(projectPath, files[i].Path)
+	}
+
+	fmt.Fprintln(w, "\n📝 Files:")
+	total := 0
+	for _, file := range files {
+		fmt.Fprintf(w, "  %s (%d bytes)\n", file.Path, len(file.Content))
+		total += len(file.Content)
+	}
+	fmt.Fprintf(w, "  %d files, %d bytes\n", len(files), total)
+
+	fmt.Fprintln(w, "\n🔧 Steps:")
+	for _, step := range tmpl.PostGenerationSteps() {
+		fmt.Fprintf(w, "  %s\n", step.Name)
+	}
+	if opts.Verify {
+		for _, stage := range verifyStages(template) {
+			fmt.Fprintf(w, "  verify: %s\n", stage.Name)
+		}
+	}
+	if opts.Git.Init {
+		fmt.Fprintln(w, "  initGitRepo")
+	}
+
+	if mode != DryRunDiff {
+		return nil
+	}
+
+	fmt.Fprintln(w, "\n🔍 Diff against existing files:")
+	if !exists {
+		fmt.Fprintf(w, "  %s does not exist: every file would be created\n", projectPath)
+	}
+	changed := 0
+	for _, file := range files {
+		existing, err := os.ReadFile(file.Path)
+		oldName := "a/" + filepath.ToSlash(file.Path)
+		if errors.Is(err, fs.ErrNotExist) {
+			oldName = "/dev/null"
+		} else if err != nil {
+			return fmt.Errorf("failed to read %s: %w", file.Path, err)
+		}
+
+		newName := "b/" + filepath.ToSlash(file.Path)
+		var diff string
+		if utf8.ValidString(file.Content) {
+			diff = generator.UnifiedDiff(oldName, newName, string(existing), file.Content)
+		} else if string(existing) != file.Content {
+			// Like git, only report that binary files (the base archive) differ
+			diff = fmt.Sprintf("Binary files %s and %s differ\n", oldName, newName)
+		}
+		if diff == "" {
+			continue
+		}
+		changed++
+		fmt.Fprint(w, colorizeDiff(diff))
+	}
+	if changed == 0 {
+		fmt.Fprintln(w, Green("  No differences"))
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tky0065/go-starter-kit/pkg/generator"
+)
+
+func TestDryRunFlagSet(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{"true", DryRunTree, false},
+		{"tree", DryRunTree, false},
+		{"diff", DryRunDiff, false},
+		{"false", DryRunOff, false},
+		{"patch", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			var f dryRunFlag
+			err := f.Set(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Set(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if !tt.wantErr && f.mode != tt.want {
+				t.Errorf("Set(%q) mode = %q, want %q", tt.value, f.mode, tt.want)
+			}
+		})
+	}
+}
+
+// TestPreviewProjectWritesNothing tests that a dry run lists the project without creating it
+func TestPreviewProjectWritesNothing(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	var out bytes.Buffer
+	if err := previewProject(&out, testProjectOptions("preview-app", generator.TemplateFull), DryRunTree); err != nil {
+		t.Fatalf("previewProject() error = %v", err)
+	}
+
+	if _, err := os.Stat("preview-app"); !os.IsNotExist(err) {
+		t.Error("dry run should not create the project directory")
+	}
+
+	output := out.String()
+	for _, want := range []string{
+		"preview-app/pkg/auth/",
+		filepath.Join("preview-app", "go.mod") + " (",
+		filepath.Join("preview-app", "setup.sh") + " (",
+		"copyEnvFile",
+		"initGitRepo",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("dry run output should contain %q, got:\n%s", want, output)
+		}
+	}
+}
+
+// TestPreviewProjectDiff tests that --dry-run=diff reports changes against an existing directory
+func TestPreviewProjectDiff(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	projectPath := "diff-app"
+	opts := testProjectOptions(projectPath, generator.TemplateMinimal)
+	if err := generator.Create(context.Background(), opts, projectPath, nil); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	// The project was generated a while ago: only rendered content is compared
+	manifestPath := filepath.Join(projectPath, generator.ManifestPath)
+	manifest, err := os.ReadFile(manifestPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := generator.LoadManifest(projectPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := strings.Replace(string(manifest), m.GeneratedAt.Format(time.RFC3339), "2020-01-02T03:04:05Z", 1)
+	if err := os.WriteFile(manifestPath, []byte(old), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	if err := previewProject(&out, opts, DryRunDiff); err != nil {
+		t.Fatalf("previewProject() error = %v", err)
+	}
+	if !strings.Contains(out.String(), "No differences") {
+		t.Errorf("unchanged project should have no differences, got:\n%s", out.String())
+	}
+
+	dockerfile := filepath.Join(projectPath, "Dockerfile")
+	if err := os.WriteFile(dockerfile, []byte("FROM scratch\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	out.Reset()
+	if err := previewProject(&out, opts, DryRunDiff); err != nil {
+		t.Fatalf("previewProject() error = %v", err)
+	}
+	if !strings.Contains(out.String(), "--- a/diff-app/Dockerfile") || !strings.Contains(out.String(), Red("-FROM scratch")) {
+		t.Errorf("diff should show the modified Dockerfile, got:\n%s", out.String())
+	}
+}
+
+// TestDryRunFlag tests --dry-run through the CLI binary
+func TestDryRunFlag(t *testing.T) {
+	testProjectName := "test-dry-run-project"
+	defer os.RemoveAll(testProjectName)
+
+	cmd := exec.Command(binaryPath, "--dry-run", "--template=minimal", testProjectName)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("Expected successful dry run, got error: %v\nOutput: %s", err, string(output))
+	}
+
+	if !strings.Contains(string(output), "nothing will be written") {
+		t.Errorf("Expected dry run banner, got: %s", string(output))
+	}
+	if _, err := os.Stat(testProjectName); !os.IsNotExist(err) {
+		t.Error("--dry-run should not create the project directory")
+	}
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestFeaturesFlag tests --features through the CLI binary
+func TestFeaturesFlag(t *testing.T) {
+	testProjectName := "test-features-flag"
+	defer os.RemoveAll(testProjectName)
+
+	cmd := exec.Command(binaryPath, "--template=minimal", "--features=users,metrics", testProjectName)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("Expected successful execution, got error: %v\nOutput: %s", err, string(output))
+	}
+
+	for _, file := range []string{"pkg/auth/jwt.go", "internal/adapters/handlers/user_handler.go", "internal/adapters/http/metrics.go"} {
+		if _, err := os.Stat(filepath.Join(testProjectName, file)); err != nil {
+			t.Errorf("--features=users,metrics should generate %s: %v", file, err)
+		}
+	}
+}
+
+// TestInvalidFeaturesFlag tests that unknown and unsupported features are rejected
+func TestInvalidFeaturesFlag(t *testing.T) {
+	tests := []struct {
+		args    []string
+		wantErr string
+	}{
+		{[]string{"--features=kafka"}, "unknown feature 'kafka'"},
+		{[]string{"--template=graphql", "--features=metrics"}, "does not support optional features"},
+	}
+
+	for _, tt := range tests {
+		cmd := exec.Command(binaryPath, append(tt.args, "test-invalid-features")...)
+		output, err := cmd.CombinedOutput()
+		if err == nil {
+			os.RemoveAll("test-invalid-features")
+			t.Fatalf("Expected error for %v", tt.args)
+		}
+		if !strings.Contains(string(output), tt.wantErr) {
+			t.Errorf("Expected %q error for %v, got: %s", tt.wantErr, tt.args, string(output))
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestFrameworkFlag tests --framework through the CLI binary
+func TestFrameworkFlag(t *testing.T) {
+	testProjectName := "test-framework-flag"
+	defer os.RemoveAll(testProjectName)
+
+	cmd := exec.Command(binaryPath, "--template=minimal", "--framework=gin", testProjectName)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("Expected successful execution, got error: %v\nOutput: %s", err, string(output))
+	}
+
+	goMod, err := os.ReadFile(filepath.Join(testProjectName, "go.mod"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(goMod), "github.com/gin-gonic/gin") {
+		t.Errorf("--framework=gin should require Gin, got:\n%s", goMod)
+	}
+	if strings.Contains(string(goMod), "github.com/gofiber/fiber") {
+		t.Errorf("--framework=gin should not require Fiber, got:\n%s", goMod)
+	}
+}
+
+// TestInvalidFrameworkFlag tests that unsupported routers are rejected
+func TestInvalidFrameworkFlag(t *testing.T) {
+	cmd := exec.Command(binaryPath, "--framework=martini", "test-invalid-framework")
+	output, err := cmd.CombinedOutput()
+	if err == nil {
+		os.RemoveAll("test-invalid-framework")
+		t.Fatal("Expected error for --framework=martini")
+	}
+	if !strings.Contains(string(output), "invalid framework 'martini': valid options are: fiber, gin, echo, chi, net/http") {
+		t.Errorf("Expected invalid framework error, got: %s", string(output))
+	}
+}
@@ -1,12 +1,18 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
+	"maps"
 	"os"
-	"path/filepath"
+	"os/signal"
 	"strings"
+	"syscall"
 
+	"github.com/tky0065/go-starter-kit/pkg/generator"
 	"github.com/tky0065/go-starter-kit/pkg/utils"
 )
 
@@ -14,35 +20,10 @@ import (
 const (
 	ColorGreen = "\033[32m"
 	ColorRed   = "\033[31m"
+	ColorCyan  = "\033[36m"
 	ColorReset = "\033[0m"
 )
 
-// Directory permissions for created folders
-const defaultDirPerm os.FileMode = 0755
-
-// Template constants define the available project generation templates.
-// - TemplateMinimal: Basic REST API with Swagger (no authentication)
-// - TemplateFull: Complete hexagonal architecture with JWT auth, user management (default)
-// - TemplateGraphQL: GraphQL API with gqlgen and GraphQL Playground (not yet implemented)
-const (
-	TemplateMinimal = "minimal"
-	TemplateFull    = "full"
-	TemplateGraphQL = "graphql"
-)
-
-// Template descriptions (in English for consistency with code)
-const (
-	TemplateMinimalDesc = "Basic REST API with Swagger (no authentication)"
-	TemplateFullDesc    = "Complete API with JWT auth, user management, and Swagger (default)"
-	TemplateGraphQLDesc = "GraphQL API with gqlgen and GraphQL Playground"
-)
-
-// ValidTemplates contains the list of valid template types
-var ValidTemplates = []string{TemplateMinimal, TemplateFull, TemplateGraphQL}
-
-// DefaultTemplate is the default template type when not specified
-const DefaultTemplate = TemplateFull
-
 // Green returns the string wrapped in green ANSI code
 func Green(msg string) string {
 	return ColorGreen + msg + ColorReset
@@ -53,91 +34,116 @@ func Red(msg string) string {
 	return ColorRed + msg + ColorReset
 }
 
-// validateTemplate checks if the template type is valid.
-// Valid templates are: minimal, full, graphql
-func validateTemplate(template string) error {
-	for _, valid := range ValidTemplates {
-		if template == valid {
-			return nil
-		}
-	}
-	return fmt.Errorf("invalid template '%s': valid options are: %s", template, strings.Join(ValidTemplates, ", "))
+// Cyan returns the string wrapped in cyan ANSI code
+func Cyan(msg string) string {
+	return ColorCyan + msg + ColorReset
 }
 
-// createProjectStructure creates the hexagonal architecture directory structure.
-// It returns an error if the directory already exists or if creation fails.
-// The template parameter determines which directories to create.
-func createProjectStructure(projectPath, template string) error {
-	// Check if project directory already exists
-	if _, err := os.Stat(projectPath); err == nil {
-		return fmt.Errorf("directory %s already exists. Please choose a different name or remove the existing directory", projectPath)
+func main() {
+	// Subcommands work on an existing project and have their own flags
+	if len(os.Args) > 1 {
+		if cmd, ok := lookupCommand(os.Args[1]); ok {
+			if err := cmd.Run(os.Args[2:]); err != nil {
+				if errors.Is(err, flag.ErrHelp) {
+					os.Exit(0)
+				}
+				fmt.Fprintln(os.Stderr, Red(fmt.Sprintf("%v", err)))
+				os.Exit(1)
+			}
+			return
+		}
 	}
 
-	// Get directories for the specified template
-	directories := getDirectoriesForTemplate(template)
+	// Parse flags
+	help := flag.Bool("help", false, "Show help message")
+	flag.BoolVar(help, "h", false, "Show help message (shorthand)")
 
-	// Create the project root directory
-	if err := os.Mkdir(projectPath, defaultDirPerm); err != nil {
-		return fmt.Errorf("failed to create project directory: %w", err)
-	}
+	var template string
+	flag.StringVar(&template, "template", generator.DefaultTemplate, "Template type to generate")
 
-	// Create each subdirectory
-	for _, dir := range directories {
-		fullPath := filepath.Join(projectPath, dir)
-		if err := os.MkdirAll(fullPath, defaultDirPerm); err != nil {
-			return fmt.Errorf("failed to create directory %s: %w", dir, err)
-		}
-	}
+	var modulePath string
+	flag.StringVar(&modulePath, "module", "", "Go module path, e.g. github.com/org/service (defaults to the project name)")
 
-	return nil
-}
+	var features string
+	flag.StringVar(&features, "features", "", "Comma-separated optional features layered on the template (e.g. auth,users,metrics)")
 
-// copyEnvFile copies the generated .env.example to .env if .env doesn't already exist.
-func copyEnvFile(projectPath string) error {
-	envExamplePath := filepath.Join(projectPath, ".env.example")
-	envPath := filepath.Join(projectPath, ".env")
+	var framework string
+	flag.StringVar(&framework, "framework", generator.DefaultFramework, "HTTP framework: "+strings.Join(generator.ValidFrameworks, ", "))
 
-	// Check if .env.example exists
-	if _, err := os.Stat(envExamplePath); os.IsNotExist(err) {
-		return fmt.Errorf(".env.example not found: %w", err)
-	}
+	var database string
+	flag.StringVar(&database, "database", generator.DefaultDBDriver, "Database driver: "+strings.Join(generator.ValidDatabases, ", "))
 
-	// Check if .env already exists
-	if _, err := os.Stat(envPath); err == nil {
-		// .env already exists, skip copying
-		return nil
-	}
+	var configPath string
+	flag.StringVar(&configPath, "config", "", "Load the project spec from a YAML or JSON file (flags override its values)")
 
-	// Read .env.example
-	content, err := os.ReadFile(envExamplePath)
-	if err != nil {
-		return fmt.Errorf("failed to read .env.example: %w", err)
-	}
+	var verify bool
+	flag.BoolVar(&verify, "verify", false, "Run go mod tidy, go vet, go build and go test in the generated project")
 
-	// Write to .env
-	if err := os.WriteFile(envPath, content, 0644); err != nil {
-		return fmt.Errorf("failed to create .env file: %w", err)
-	}
+	var output string
+	flag.StringVar(&output, "output", OutputText, "Output format: "+strings.Join(ValidOutputFormats, ", ")+" (json prints a single report for scripts)")
 
-	return nil
-}
+	var templateDir string
+	flag.StringVar(&templateDir, "template-dir", "", "Load a template pack from a directory (with a "+generator.TemplatePackManifest+" manifest) and use it as the template")
 
-func main() {
-	// Parse flags
-	help := flag.Bool("help", false, "Show help message")
-	flag.BoolVar(help, "h", false, "Show help message (shorthand)")
+	var overridesDir string
+	flag.StringVar(&overridesDir, "overrides", "", "Directory of files replacing or adding generated files (e.g. "+generator.OverridesDir+")")
 
-	var template string
-	flag.StringVar(&template, "template", DefaultTemplate, "Template type to generate")
+	vars := varsFlag{}
+	flag.Var(vars, "var", "Set a template variable, as name=value (repeatable)")
+
+	var noGit bool
+	flag.BoolVar(&noGit, "no-git", false, "Do not initialize a git repository")
+
+	var gitBranch string
+	flag.StringVar(&gitBranch, "git-branch", "", "Branch of the initial commit (defaults to the git init.defaultBranch setting)")
+
+	var gitRemote string
+	flag.StringVar(&gitRemote, "git-remote", "", "URL recorded as the origin remote of the repository (nothing is pushed)")
+
+	var gitAuthor string
+	flag.StringVar(&gitAuthor, "git-author", "", "Author of the initial commit, as \"Name <email>\" (defaults to the git user.name and user.email)")
+
+	var installHooks bool
+	flag.BoolVar(&installHooks, "install-hooks", false, "Install pre-commit and pre-push git hooks running gofmt, go vet and golangci-lint")
+
+	var dryRun dryRunFlag
+	flag.Var(&dryRun, "dry-run", "Preview the generated project without writing anything (--dry-run=diff also diffs against an existing directory)")
+
+	var archive string
+	flag.StringVar(&archive, "archive", "", "Write the project to a .tar.gz or .zip archive instead of a directory (- for stdout)")
+
+	var archiveFormatName string
+	flag.StringVar(&archiveFormatName, "archive-format", "", "Archive format: "+strings.Join(generator.ValidArchiveFormats, ", ")+" (defaults to the --archive extension, tar.gz for stdout)")
 
 	flag.Usage = func() {
-		fmt.Fprintf(os.Stderr, "Usage: create-go-starter [options] <project-name>\n\n")
+		fmt.Fprintf(os.Stderr, "Usage: create-go-starter [options] <project-name>\n")
+		fmt.Fprintf(os.Stderr, "       create-go-starter --config project.yaml [options] [project-name]\n")
+		for _, cmd := range commands {
+			fmt.Fprintf(os.Stderr, "       create-go-starter %s\n", cmd.Usage)
+		}
+		fmt.Fprintf(os.Stderr, "\n")
 		fmt.Fprintf(os.Stderr, "Options:\n")
 		flag.PrintDefaults()
+		fmt.Fprintf(os.Stderr, "\nCommands:\n")
+		for _, cmd := range commands {
+			fmt.Fprintf(os.Stderr, "  %-9s %s\n", cmd.Name, cmd.Description)
+		}
 		fmt.Fprintf(os.Stderr, "\nTemplates:\n")
-		fmt.Fprintf(os.Stderr, "  %-9s %s\n", TemplateMinimal, TemplateMinimalDesc) // Adjusted formatting
-		fmt.Fprintf(os.Stderr, "  %-9s %s\n", TemplateFull, TemplateFullDesc)       // Adjusted formatting
-		fmt.Fprintf(os.Stderr, "  %-9s %s\n", TemplateGraphQL, TemplateGraphQLDesc) // Adjusted formatting
+		for _, tmpl := range generator.Templates() {
+			fmt.Fprintf(os.Stderr, "  %-9s %s\n", tmpl.Name(), tmpl.Description())
+		}
+		fmt.Fprintf(os.Stderr, "\nFrameworks:\n")
+		for _, fw := range generator.Frameworks() {
+			fmt.Fprintf(os.Stderr, "  %-9s %s\n", fw.Name, fw.Description)
+		}
+		fmt.Fprintf(os.Stderr, "\nDatabases:\n")
+		for _, db := range generator.Databases() {
+			fmt.Fprintf(os.Stderr, "  %-9s %s\n", db.Name, db.Description)
+		}
+		fmt.Fprintf(os.Stderr, "\nFeatures (minimal and full templates):\n")
+		for _, f := range generator.Features() {
+			fmt.Fprintf(os.Stderr, "  %-9s %s\n", f.Name, f.Description)
+		}
 	}
 
 	flag.Parse()
@@ -147,158 +153,506 @@ func main() {
 		os.Exit(0)
 	}
 
-	args := flag.Args()
-	if len(args) < 1 {
-		// Changed to not include "Error: " here, as Red() function will add color to the message itself.
-		fmt.Fprintln(os.Stderr, Red("Project name is required"))
-		flag.Usage()
-		os.Exit(1)
+	// Start from the config file, if any, then apply the flags set explicitly
+	opts := generator.DefaultProjectOptions()
+	jsonOutput := output == OutputJSON
+
+	// fail reports err and exits with the exit code of its failure class. With
+	// --output=json the error goes in the report printed to stdout.
+	fail := func(err error, usage bool) {
+		if jsonOutput {
+			report := newGenerationReport(opts)
+			report.fail(err)
+			report.write(os.Stdout)
+		} else {
+			// Changed to not include "Error: " prefix as Red() function will color the message itself.
+			fmt.Fprintln(os.Stderr, Red(fmt.Sprintf("%v", err)))
+			if usage {
+				flag.Usage()
+			}
+		}
+		os.Exit(failureOf(err).ExitCode)
+	}
+
+	if err := validateOutputFormat(output); err != nil {
+		jsonOutput = false
+		fail(classify(failureValidation, err), false)
 	}
+	if jsonOutput && dryRun.mode != DryRunOff {
+		fail(classify(failureValidation, errors.New("--output=json cannot be combined with --dry-run")), false)
+	}
+	if archive != "" && dryRun.mode != DryRunOff {
+		fail(classify(failureValidation, errors.New("--archive cannot be combined with --dry-run")), false)
+	}
+	if jsonOutput && archive == archiveStdout {
+		fail(classify(failureValidation, errors.New("--output=json cannot be combined with --archive=-: both write to stdout")), false)
+	}
+	var format string
+	if archive != "" {
+		var err error
+		if format, err = archiveFormat(archive, archiveFormatName); err != nil {
+			fail(classify(failureValidation, err), false)
+		}
+	}
+
+	if configPath != "" {
+		loaded, err := generator.LoadProjectConfig(configPath)
+		if err != nil {
+			fail(classify(failureValidation, err), false)
+		}
+		opts = loaded
+	}
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "template":
+			opts.Template = template
+		case "module":
+			opts.ModulePath = modulePath
+		case "features":
+			opts.Features = generator.ParseFeatureList(features)
+		case "framework":
+			opts.Framework = framework
+		case "database":
+			opts.Database = database
+		case "no-git":
+			opts.Git.Init = !noGit
+		case "git-branch":
+			opts.Git.Branch = gitBranch
+		case "git-remote":
+			opts.Git.Remote = gitRemote
+		case "git-author":
+			opts.Git.Author = gitAuthor
+		case "install-hooks":
+			opts.Git.InstallHooks = installHooks
+		case "var":
+			if opts.Variables == nil {
+				opts.Variables = map[string]string{}
+			}
+			maps.Copy(opts.Variables, vars)
+		}
+	})
 
-	projectName := args[0]
+	// A template pack is registered before the wizard so that it is offered
+	if templateDir != "" {
+		name, err := registerTemplateDir(templateDir)
+		if err != nil {
+			fail(classify(failureValidation, err), false)
+		}
+		opts.Template = name
+	}
+	// Overrides are only applied on request: a generated project keeps its
+	// own in .go-starter/overrides, which must not leak into a new project
+	// generated from inside it
+	if overridesDir != "" {
+		overrides, err := loadOverridesDir(overridesDir)
+		if err != nil {
+			fail(classify(failureValidation, fmt.Errorf("invalid overrides directory: %w", err)), false)
+		}
+		opts.Overrides = overrides
+	}
+
+	opts.Verify = verify
+
+	args := flag.Args()
+	if len(args) > 0 {
+		opts.ProjectName = args[0]
+	}
+	if opts.ProjectName == "" && !jsonOutput && isInteractive() {
+		// No project name on a terminal: ask for the options interactively
+		answers, err := runWizard(newReaderInput(os.Stdin), os.Stdout, opts)
+		if errors.Is(err, errWizardCancelled) {
+			fmt.Println(err)
+			return
+		}
+		if err != nil {
+			fail(err, false)
+		}
+		opts = answers
+	}
+	if opts.ProjectName == "" {
+		fail(classify(failureValidation, errors.New("Project name is required")), true)
+	}
 
 	// Validate project name using the shared utility
-	if err := utils.ValidateGoModuleName(projectName); err != nil {
-		fmt.Fprintln(os.Stderr, Red(fmt.Sprintf("%v", err)))
-		flag.Usage() // Display usage on invalid project name
-		os.Exit(1)
+	if err := utils.ValidateGoModuleName(opts.ProjectName); err != nil {
+		fail(classify(failureValidation, err), true) // Display usage on invalid project name
 	}
 
-	// Validate template
-	if err := validateTemplate(template); err != nil {
-		// Changed to not include "Error: " prefix as Red() function will color the message itself.
-		fmt.Fprintln(os.Stderr, Red(fmt.Sprintf("%v", err)))
-		os.Exit(1)
+	// Validate template, module path and the other spec values
+	if err := opts.Validate(); err != nil {
+		fail(classify(failureValidation, err), false)
 	}
 
-	// Run the project creation logic
-	if err := run(projectName, template); err != nil {
-		// Changed to not include "Error: " prefix as Red() function will color the message itself.
-		fmt.Fprintln(os.Stderr, Red(fmt.Sprintf("%v", err)))
-		os.Exit(1)
+	// Preview the project instead of creating it
+	if dryRun.mode != DryRunOff {
+		if err := previewProject(os.Stdout, opts, dryRun.mode); err != nil {
+			fail(err, false)
+		}
+		return
+	}
+
+	// Run the project creation logic, printing its progress or, with
+	// --output=json, only the final report. An archive written to stdout
+	// leaves the progress to stderr.
+	generate := generateProject
+	progress := io.Writer(os.Stdout)
+	if archive != "" {
+		generate = func(w io.Writer, opts generator.ProjectOptions) (*generationReport, error) {
+			return archiveProject(w, opts, archive, format)
+		}
+		if archive == archiveStdout {
+			progress = os.Stderr
+		}
+	}
+	if !jsonOutput {
+		if _, err := generate(progress, opts); err != nil {
+			fail(err, false)
+		}
+		return
+	}
+	report, err := generate(io.Discard, opts)
+	if err != nil {
+		report.fail(err)
 	}
+	report.write(os.Stdout)
+	os.Exit(report.exitCode())
 }
 
-// run executes the main project creation logic.
-// It validates the project name, creates the directory structure,
-// generates files, and initializes git.
-// Returns an error if any step fails (except git initialization which is non-fatal).
+// run executes the main project creation logic for a project whose module
+// path is its name. See runWithOptions.
 func run(projectName, template string) error {
-	// Display start message with template info
-	fmt.Println(Green(fmt.Sprintf("Creating project: %s (template: %s)", projectName, template)))
+	opts := generator.DefaultProjectOptions()
+	opts.ProjectName = projectName
+	opts.Template = template
+	return runWithOptions(opts)
+}
 
-	// Validate project name again to ensure safety when run() is called directly (e.g. in tests)
-	if err := utils.ValidateGoModuleName(projectName); err != nil {
-		return err
-	}
+// runWithOptions executes the main project creation logic, printing its
+// progress to stdout. See generateProject.
+func runWithOptions(opts generator.ProjectOptions) error {
+	_, err := generateProject(os.Stdout, opts)
+	return err
+}
+
+// generateProject executes the main project creation logic, printing its
+// progress to w. It validates the options, creates the directory structure,
+// generates files, and initializes git. The project is built in a staging
+// directory and moved into place once complete, so a failure leaves nothing behind.
+// It returns the report of the generation, and an error classified by
+// failure class if any step fails (except hooks declared with on_failure:
+// warn and the git initialization, which are reported as warnings unless the
+// repository was set up by the git options: see checkGitRepo).
+func generateProject(w io.Writer, opts generator.ProjectOptions) (*generationReport, error) {
+	projectName := opts.ProjectName
+	report := newGenerationReport(opts)
+
+	printProjectHeader(w, opts)
 
 	// Use project name as directory path (relative to current directory)
 	projectPath := projectName
 
-	// Display progress message
-	fmt.Println("📁 Creating directories...") // Changed to English
+	// Generate the project in a staging directory next to the target so that
+	// any failure (or Ctrl-C) leaves nothing behind
+	err := runInterruptible(func(ctx context.Context) error {
+		return generator.Create(ctx, opts, projectPath, report.recordWarnings(printProgress(w)))
+	})
+	if err != nil {
+		return report, classifyGeneration(err)
+	}
+	report.Project = projectPath
+
+	// Check that the project builds and passes its tests, before the initial
+	// commit so that it includes go.sum and the generated code
+	if opts.Verify {
+		fmt.Fprintln(w, "🔍 Verifying the generated project...")
+		report.Verification = verifyProject(w, projectPath, verifyStages(opts.Template))
+	}
 
-	// Create the project structure
-	if err := createProjectStructure(projectPath, template); err != nil {
-		return err
+	// List what was generated, before git adds its own directory
+	if err := report.inventory(projectPath); err != nil {
+		return report, classify(failureWrite, err)
 	}
 
-	fmt.Println(Green("✅ Structure created")) // Changed to English
+	// Initialize Git repository (AC: 1, 2, 3, 4, 5)
+	initProjectRepo(w, report, opts, projectPath)
+
+	if err := checkVerification(w, report, projectName); err != nil {
+		return report, err
+	}
+	if err := checkGitRepo(report, opts); err != nil {
+		return report, err
+	}
+
+	report.OK = true
+	report.NextSteps = nextSteps(projectName)
+
+	// Display success message with detailed setup instructions
+	printSuccessMessage(w, opts)
 
-	// Generate project files with dynamic context injection
-	fmt.Println("📝 Generating core files...") // Changed to English
+	return report, nil
+}
 
-	if err := generateProjectFiles(projectPath, projectName, template); err != nil {
-		return err
+// printProjectHeader displays the start message with the template info
+func printProjectHeader(w io.Writer, opts generator.ProjectOptions) {
+	fmt.Fprintln(w, Green(fmt.Sprintf("Creating project: %s (template: %s)", opts.ProjectName, opts.Template)))
+	if opts.ModulePath != "" {
+		fmt.Fprintln(w, Green(fmt.Sprintf("Module: %s", opts.ModulePath)))
+	}
+	if len(opts.Features) > 0 {
+		fmt.Fprintln(w, Green(fmt.Sprintf("Features: %s", strings.Join(opts.Features, ", "))))
+	}
+	if opts.Framework != "" && opts.Framework != generator.DefaultFramework {
+		fmt.Fprintln(w, Green(fmt.Sprintf("Framework: %s", opts.Framework)))
+	}
+	if opts.Database != "" && opts.Database != generator.DefaultDBDriver {
+		fmt.Fprintln(w, Green(fmt.Sprintf("Database: %s", opts.Database)))
 	}
+}
 
-	// Display success message
-	fmt.Println(Green("✅ Files generated successfully")) // Changed to English
+// runInterruptible runs fn with a context cancelled on Ctrl-C or SIGTERM. fn
+// must clean up after itself when the context is cancelled: when fn fails
+// after an interruption, runInterruptible reports that nothing was left behind
+// and exits with status 130. A signal arriving after fn completed its work
+// does not undo it, so fn's result is returned as is.
+func runInterruptible(fn func(ctx context.Context) error) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	err := fn(ctx)
+	interrupted := err != nil && ctx.Err() != nil
+	stop()
+	if interrupted {
+		fmt.Fprintln(os.Stderr, Red("Generation interrupted: no files were left behind"))
+		os.Exit(130)
+	}
+	return err
+}
 
-	// Copy .env.example to .env
-	fmt.Println("🔑 Configuring environment...") // Changed to English
-	if err := copyEnvFile(projectPath); err != nil {
-		return err
+// initProjectRepo initializes the git repository of the project generated in
+// projectPath, if opts asks for one, and records the outcome in report. A
+// failure is not fatal: it is printed to w as a warning, with the commands to
+// initialize the repository manually.
+func initProjectRepo(w io.Writer, report *generationReport, opts generator.ProjectOptions, projectPath string) {
+	switch {
+	case !opts.Git.Init:
+		fmt.Fprintln(w, "⏭️  Git initialization skipped")
+		return
+	case !generator.IsGitAvailable():
+		report.Warnings = append(report.Warnings, "git is not installed: the repository was not initialized")
+		fmt.Fprintln(w, Red("⚠️  Git is not installed: repository initialization skipped"))
+		fmt.Fprintln(w, "   You can initialize the repository manually later with:")
+		fmt.Fprintln(w, "   "+manualGitCommand(opts))
+		return
 	}
 
-	// Initialize Git repository (AC: 1, 2, 3, 4, 5)
-	fmt.Println("🔧 Initializing Git repository...") // Changed to English
-	if err := initGitRepo(projectPath); err != nil {
+	fmt.Fprintln(w, "🔧 Initializing Git repository...")
+	err := generator.InitGitRepoWithOptions(projectPath, opts.Git)
+	switch {
+	case errors.Is(err, generator.ErrGitIdentity):
+		// Explain the missing identity rather than dumping the git output
+		report.Git.Error = err.Error()
+		report.Warnings = append(report.Warnings, "git user.name and user.email are not set: the repository was not initialized")
+		fmt.Fprintln(w, Red("⚠️  Git does not know who you are: repository initialization skipped"))
+		fmt.Fprintln(w, "   Set your identity, or pass --git-author \"Name <email>\":")
+		fmt.Fprintln(w, "   git config --global user.name \"Your Name\"")
+		fmt.Fprintln(w, "   git config --global user.email \"you@example.com\"")
+		fmt.Fprintln(w, "   Then initialize the repository manually:")
+		fmt.Fprintln(w, "   "+manualGitCommand(opts))
+	case err != nil:
 		// Non-fatal: warn user but continue
-		fmt.Println(Red(fmt.Sprintf("⚠️  Git warning: %v", err)))           // Changed to English
-		fmt.Println("   You can initialize the repository manually later.") // Changed to English
-	} else if isGitAvailable() {
-		fmt.Println(Green("✅ Git repository initialized with initial commit")) // Changed to English
+		report.Git.Error = err.Error()
+		report.Warnings = append(report.Warnings, fmt.Sprintf("git initialization failed: %v", err))
+		fmt.Fprintln(w, Red(fmt.Sprintf("⚠️  Git warning: %v", err)))
+		fmt.Fprintln(w, "   You can initialize the repository manually later.")
+	default:
+		report.Git.Initialized = true
+		report.Git.HooksInstalled = opts.Git.InstallHooks
+		fmt.Fprintln(w, Green("✅ Git repository initialized with initial commit"))
+		if opts.Git.Remote != "" {
+			fmt.Fprintf(w, "   Remote origin: %s (not pushed)\n", opts.Git.Remote)
+		}
+		if opts.Git.InstallHooks {
+			fmt.Fprintln(w, "   Git hooks installed: pre-commit, pre-push")
+		}
 	}
+}
 
-	// Display success message with detailed setup instructions
-	printSuccessMessage(projectName)
+// manualGitCommand returns the command initializing the repository of the
+// project by hand, on the branch set by opts
+func manualGitCommand(opts generator.ProjectOptions) string {
+	init := "git init"
+	if opts.Git.Branch != "" {
+		init += " -b " + opts.Git.Branch
+	}
+	return "cd " + opts.ProjectName + " && " + init + " && git add . && git commit -m \"Initial commit\""
+}
 
+// checkVerification returns a failureVerification error if the --verify
+// stages recorded in report failed
+func checkVerification(w io.Writer, report *generationReport, projectName string) error {
+	if report.Verification == nil {
+		return nil
+	}
+	if failed := report.Verification.failed(); failed != nil {
+		return classify(failureVerification, fmt.Errorf("project %s was generated but failed verification at '%s'", projectName, failed.Stage))
+	}
+	fmt.Fprintln(w, Green("✅ Project verified"))
 	return nil
 }
 
+// checkGitRepo returns a failureGit error when the git repository of the
+// project was not initialized although the git options set its branch,
+// remote, author or hooks: the project does not match what was asked for. A
+// repository initialized with the defaults only gets a warning.
+func checkGitRepo(report *generationReport, opts generator.ProjectOptions) error {
+	git := opts.Git
+	if !git.Init || report.Git.Initialized || (git.Branch == "" && git.Remote == "" && git.Author == "" && !git.InstallHooks) {
+		return nil
+	}
+	return classify(failureGit, fmt.Errorf("project %s was generated but its git repository was not initialized with the requested git options", opts.ProjectName))
+}
+
+// printProgress returns a generator.Progress printing the start and end of
+// each generation stage to w
+func printProgress(w io.Writer) generator.Progress {
+	return func(e generator.Event) {
+		switch {
+		case e.Path != "":
+			// Files and directories are not listed one by one
+		case e.Stage == generator.StageDirectories && !e.Done:
+			fmt.Fprintln(w, "📁 Creating directories...") // Changed to English
+		case e.Stage == generator.StageDirectories:
+			fmt.Fprintln(w, Green("✅ Structure created")) // Changed to English
+		case e.Stage == generator.StageFiles && !e.Done:
+			fmt.Fprintln(w, "📝 Generating core files...") // Changed to English
+		case e.Stage == generator.StageFiles:
+			fmt.Fprintln(w, Green("✅ Files generated successfully")) // Changed to English
+		case e.Stage == generator.StageStep && !e.Done:
+			fmt.Fprintln(w, e.Message)
+		case e.Stage == generator.StageHook && !e.Done:
+			fmt.Fprintln(w, e.Message)
+		case e.Stage == generator.StageHook && e.Warning != "":
+			// Non-fatal: the hook declared on_failure: warn
+			fmt.Fprintln(w, Red(fmt.Sprintf("⚠️  Hook warning: %s", e.Warning)))
+		}
+	}
+}
+
 // printSuccessMessage displays the final success message and setup instructions
-func printSuccessMessage(projectName string) {
-	fmt.Printf("\n%s\n", Green("════════════════════════════════════════════════════════════════"))
-	fmt.Printf("%s\n", Green(fmt.Sprintf("🎉 Project '%s' created successfully!", projectName))) // Changed to English
-	fmt.Printf("%s\n\n", Green("════════════════════════════════════════════════════════════════"))
-
-	fmt.Println("📋 Next steps - Initial setup:") // Changed to English
-	fmt.Println()
-
-	fmt.Println(Green("OPTION 1: Automatic setup (Recommended) 🚀")) // Changed to English
-	fmt.Println("  cd " + projectName)
-	fmt.Println("  ./setup.sh")
-	fmt.Println()
-
-	fmt.Println(Green("OPTION 2: Manual setup")) // Changed to English
-	fmt.Println()
-	fmt.Println("1️⃣  Navigate to your project:") // Changed to English
-	fmt.Println("    cd " + projectName)
-	fmt.Println()
-
-	fmt.Println("2️⃣  Configure PostgreSQL (choose one option):") // Changed to English
-	fmt.Println()
-	fmt.Println("    Option A - Docker (Recommended):") // Changed to English
-	dockerCmd := `docker run -d --name postgres \
+// for the database driver and the features of opts
+func printSuccessMessage(w io.Writer, opts generator.ProjectOptions) {
+	opts = opts.WithDefaults()
+	projectName := opts.ProjectName
+	auth := opts.TemplateData().HasFeature("auth")
+	db, ok := generator.LookupDatabase(opts.Database)
+	if !ok {
+		db = generator.Databases()[0]
+	}
+
+	fmt.Fprintf(w, "\n%s\n", Green("════════════════════════════════════════════════════════════════"))
+	fmt.Fprintf(w, "%s\n", Green(fmt.Sprintf("🎉 Project '%s' created successfully!", projectName))) // Changed to English
+	fmt.Fprintf(w, "%s\n\n", Green("════════════════════════════════════════════════════════════════"))
+
+	fmt.Fprintln(w, "📋 Next steps - Initial setup:") // Changed to English
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, Green("OPTION 1: Automatic setup (Recommended) 🚀")) // Changed to English
+	fmt.Fprintln(w, "  cd "+projectName)
+	fmt.Fprintln(w, "  ./setup.sh")
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, Green("OPTION 2: Manual setup")) // Changed to English
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "1️⃣  Navigate to your project:") // Changed to English
+	fmt.Fprintln(w, "    cd "+projectName)
+	fmt.Fprintln(w)
+
+	printDatabaseSetup(w, projectName, db)
+
+	// The JWT secret step only exists for projects with the auth feature
+	step := 3
+	if auth {
+		fmt.Fprintf(w, "%s  Generate JWT secret (REQUIRED):\n", keycap(step)) // Changed to English
+		fmt.Fprintln(w, "    openssl rand -base64 32")
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "    Then edit .env and add:")       // Changed to English
+		fmt.Fprintln(w, "    JWT_SECRET=<generated_secret>") // Changed to English
+		fmt.Fprintln(w)
+		step++
+	}
+
+	fmt.Fprintf(w, "%s  Start the application:\n", keycap(step)) // Changed to English
+	fmt.Fprintln(w, "    make run")
+	fmt.Fprintln(w)
+
+	fmt.Fprintf(w, "%s  Verify installation:\n", keycap(step+1)) // Changed to English
+	fmt.Fprintln(w, "    curl http://localhost:8080/health")
+	fmt.Fprintln(w, "    # Should return: {\"status\":\"ok\"}") // Changed to English
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, Green("📚 Full documentation:"))                                // Changed to English
+	fmt.Fprintln(w, "   - Quick Start Guide: "+projectName+"/docs/quick-start.md") // Changed to English
+	fmt.Fprintln(w, "   - README:            "+projectName+"/README.md")           // Changed to English
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, Green("⚠️  IMPORTANT:")) // Changed to English
+	if db.Server() {
+		fmt.Fprintf(w, "   • %s MUST be started before launching the application\n", db.Title)
+	}
+	if auth {
+		fmt.Fprintln(w, "   • JWT_SECRET MUST be configured in .env") // Changed to English
+	}
+	fmt.Fprintln(w, "   • The .env file was automatically created from .env.example") // Changed to English
+	fmt.Fprintln(w, "   • Run 'create-go-starter doctor' in the project to check your setup")
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, Green("✨ Happy developing with "+projectName+"!")) // Changed to English
+}
+
+// keycap returns the keycap emoji numbering the setup step n (1 to 9)
+func keycap(n int) string {
+	return fmt.Sprintf("%d\uFE0F\u20E3", n)
+}
+
+// printDatabaseSetup displays the instructions to get the database running
+func printDatabaseSetup(w io.Writer, projectName string, db *generator.Database) {
+	if !db.Server() {
+		fmt.Fprintf(w, "2️⃣  Database: %s needs no server\n", db.Title)
+		fmt.Fprintf(w, "    %s.db is created on first start (set DB_PATH in .env to move it)\n", projectName)
+		fmt.Fprintln(w)
+		return
+	}
+
+	fmt.Fprintf(w, "2️⃣  Configure %s (choose one option):\n", db.Title)
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "    Option A - Docker (Recommended):")
+	var dockerCmd string
+	switch db.Name {
+	case "mysql":
+		dockerCmd = `docker run -d --name mysql \
+      -e MYSQL_ROOT_PASSWORD=root \
+      -e MYSQL_DATABASE=` + projectName + ` \
+      -e MYSQL_USER=app \
+      -e MYSQL_PASSWORD=app \
+      -p 3306:3306 \
+      ` + db.Image
+	default:
+		dockerCmd = `docker run -d --name postgres \
       -e POSTGRES_DB=` + projectName + ` \
       -e POSTGRES_PASSWORD=postgres \
       -p 5432:5432 \
-      postgres:16-alpine`
-	fmt.Println(dockerCmd)
-	fmt.Println()
-	fmt.Println("    Option B - Local PostgreSQL:") // Changed to English
-	fmt.Println("    # macOS: brew install postgresql && brew services start postgresql")
-	fmt.Println("    # Linux: sudo apt install postgresql && sudo systemctl start postgresql")
-	fmt.Println("    createdb " + projectName)
-	fmt.Println()
-
-	fmt.Println("3️⃣  Generate JWT secret (REQUIRED):") // Changed to English
-	fmt.Println("    openssl rand -base64 32")
-	fmt.Println()
-	fmt.Println("    Then edit .env and add:")       // Changed to English
-	fmt.Println("    JWT_SECRET=<generated_secret>") // Changed to English
-	fmt.Println()
-
-	fmt.Println("4️⃣  Start the application:") // Changed to English
-	fmt.Println("    make run")
-	fmt.Println()
-
-	fmt.Println("5️⃣  Verify installation:") // Changed to English
-	fmt.Println("    curl http://localhost:8080/health")
-	fmt.Println("    # Should return: {\"status\":\"ok\"}") // Changed to English
-	fmt.Println()
-
-	fmt.Println(Green("📚 Full documentation:"))                                    // Changed to English
-	fmt.Println("   - Quick Start Guide: " + projectName + "/docs/quick-start.md") // Changed to English
-	fmt.Println("   - README:            " + projectName + "/README.md")           // Changed to English
-	fmt.Println()
-
-	fmt.Println(Green("⚠️  IMPORTANT:"))                                            // Changed to English
-	fmt.Println("   • PostgreSQL MUST be started before launching the application") // Changed to English
-	fmt.Println("   • JWT_SECRET MUST be configured in .env")                       // Changed to English
-	fmt.Println("   • The .env file was automatically created from .env.example")   // Changed to English
-	fmt.Println()
-
-	fmt.Println(Green("✨ Happy developing with " + projectName + "!")) // Changed to English
+      ` + db.Image
+	}
+	fmt.Fprintln(w, dockerCmd)
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "    Option B - Local %s:\n", db.Title)
+	switch db.Name {
+	case "mysql":
+		fmt.Fprintln(w, "    # macOS: brew install mysql && brew services start mysql")
+		fmt.Fprintln(w, "    # Linux: sudo apt install mysql-server && sudo systemctl start mysql")
+		fmt.Fprintln(w, "    mysql -u root -p -e \"CREATE DATABASE `"+projectName+"`; CREATE USER 'app'@'%' IDENTIFIED BY 'app'; GRANT ALL ON `"+projectName+"`.* TO 'app'@'%';\"")
+	default:
+		fmt.Fprintln(w, "    # macOS: brew install postgresql && brew services start postgresql")
+		fmt.Fprintln(w, "    # Linux: sudo apt install postgresql && sudo systemctl start postgresql")
+		fmt.Fprintln(w, "    createdb "+projectName)
+	}
+	fmt.Fprintln(w)
 }
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/exec"
@@ -9,6 +10,8 @@ import (
 	//"regexp" // Removed as regex is not used directly anymore
 	"strings"
 	"testing"
+
+	"github.com/tky0065/go-starter-kit/pkg/generator"
 )
 
 var binaryPath string
@@ -187,7 +190,7 @@ func TestRunFunction(t *testing.T) {
 	}
 
 	// Run the main logic
-	err = run(projectName, DefaultTemplate)
+	err = run(projectName, generator.DefaultTemplate)
 	if err != nil {
 		t.Errorf("run() returned error: %v", err)
 	}
@@ -217,7 +220,7 @@ func TestRunFunction(t *testing.T) {
 
 // TestRunFunctionWithInvalidName tests that run() fails with invalid project name
 func TestRunFunctionWithInvalidName(t *testing.T) {
-	err := run("../invalid-path", DefaultTemplate)
+	err := run("../invalid-path", generator.DefaultTemplate)
 	if err == nil {
 		t.Error("Expected error for invalid project name, got nil")
 	}
@@ -228,7 +231,7 @@ func TestRunFunctionWithInvalidName(t *testing.T) {
 
 // TestRunFunctionWithEmptyName tests that run() fails with empty project name
 func TestRunFunctionWithEmptyName(t *testing.T) {
-	err := run("", DefaultTemplate)
+	err := run("", generator.DefaultTemplate)
 	if err == nil {
 		t.Error("Expected error for empty project name, got nil")
 	}
@@ -257,7 +260,7 @@ func TestRunFunctionWithExistingDirectory(t *testing.T) {
 	}
 
 	// Run should fail because directory exists
-	err = run(projectName, DefaultTemplate)
+	err = run(projectName, generator.DefaultTemplate)
 	if err == nil {
 		t.Error("Expected error for existing directory, got nil")
 	}
@@ -266,84 +269,54 @@ func TestRunFunctionWithExistingDirectory(t *testing.T) {
 	}
 }
 
-// TestPrintSuccessMessage tests that printSuccessMessage doesn't panic
+// TestPrintSuccessMessage tests that the JWT secret instructions are only
+// printed for projects with the auth feature
 func TestPrintSuccessMessage(t *testing.T) {
-	// This test just verifies the function doesn't panic
-	defer func() {
-		if r := recover(); r != nil {
-			t.Errorf("printSuccessMessage() panicked: %v", r)
-		}
-	}()
-
-	printSuccessMessage("test-project")
-}
-
-// TestValidateTemplateValid tests validateTemplate with valid templates (AC: 1, 2)
-func TestValidateTemplateValid(t *testing.T) {
 	tests := []struct {
-		name     string
 		template string
+		features []string
+		wantJWT  bool
 	}{
-		{"minimal template", "minimal"},
-		{"full template", "full"},
-		{"graphql template", "graphql"},
+		{generator.TemplateFull, nil, true},
+		{generator.TemplateMinimal, nil, false},
+		{generator.TemplateMinimal, []string{"auth"}, true},
+		{generator.TemplateGraphQL, nil, false},
 	}
-
 	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			err := validateTemplate(tt.template)
-			if err != nil {
-				t.Errorf("validateTemplate(%q) returned error: %v, want nil", tt.template, err)
-			}
-		})
-	}
-}
+		opts := testProjectOptions("test-project", tt.template)
+		opts.Features = tt.features
+		var out strings.Builder
+		printSuccessMessage(&out, opts)
 
-// TestValidateTemplateInvalid tests validateTemplate with invalid templates (AC: 3)
-func TestValidateTemplateInvalid(t *testing.T) {
-	tests := []struct {
-		name     string
-		template string
-	}{
-		{"empty template", ""},
-		{"unknown template", "unknown"},
-		{"invalid case", "FULL"},
-		{"invalid spacing", " full"},
-		{"invalid template", "invalid"},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			err := validateTemplate(tt.template)
-			if err == nil {
-				t.Errorf("validateTemplate(%q) returned nil, want error", tt.template)
-			}
-			if !strings.Contains(err.Error(), "invalid template") {
-				t.Errorf("validateTemplate(%q) error = %v, want error containing 'invalid template'", tt.template, err)
-			}
-			if !strings.Contains(err.Error(), "minimal, full, graphql") {
-				t.Errorf("validateTemplate(%q) error = %v, want error listing valid options", tt.template, err)
-			}
-		})
+		if got := strings.Contains(out.String(), "JWT_SECRET"); got != tt.wantJWT {
+			t.Errorf("%s %v: JWT_SECRET instructions printed = %v, want %v:\n%s", tt.template, tt.features, got, tt.wantJWT, out.String())
+		}
+		startStep := "3️⃣  Start the application"
+		if tt.wantJWT {
+			startStep = "4️⃣  Start the application"
+		}
+		if !strings.Contains(out.String(), startStep) {
+			t.Errorf("%s %v: output should contain %q:\n%s", tt.template, tt.features, startStep, out.String())
+		}
 	}
 }
 
 // TestTemplateDefaultValue tests that default template is "full" (AC: 2)
 func TestTemplateDefaultValue(t *testing.T) {
-	if DefaultTemplate != "full" {
-		t.Errorf("DefaultTemplate = %q, want %q", DefaultTemplate, "full")
+	if generator.DefaultTemplate != "full" {
+		t.Errorf("DefaultTemplate = %q, want %q", generator.DefaultTemplate, "full")
 	}
 }
 
 // TestValidTemplatesContains tests that ValidTemplates contains expected values
 func TestValidTemplatesContains(t *testing.T) {
 	expected := []string{"minimal", "full", "graphql"}
-	if len(ValidTemplates) != len(expected) {
-		t.Errorf("ValidTemplates has %d elements, want %d", len(ValidTemplates), len(expected))
+	if len(generator.ValidTemplates()) != len(expected) {
+		t.Errorf("ValidTemplates has %d elements, want %d", len(generator.ValidTemplates()), len(expected))
 	}
 	for _, exp := range expected {
 		found := false
-		for _, valid := range ValidTemplates {
+		for _, valid := range generator.ValidTemplates() {
 			if valid == exp {
 				found = true
 				break
@@ -481,3 +454,238 @@ func TestHelpShowsTemplateFlag(t *testing.T) {
 		t.Errorf("Expected 'graphql' template description in help, got: %s", outputStr)
 	}
 }
+
+// TestGitFlags tests --no-git, --git-branch, --git-remote and --install-hooks
+// through the CLI binary
+func TestGitFlags(t *testing.T) {
+	if !generator.IsGitAvailable() {
+		t.Skip("git is not installed")
+	}
+	binary, err := filepath.Abs(binaryPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+
+	cmd := exec.Command(binary, "--template=minimal", "--no-git", "test-no-git")
+	cmd.Dir = dir
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("--no-git error = %v\n%s", err, output)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "test-no-git", ".git")); !os.IsNotExist(err) {
+		t.Error("--no-git should not create a repository")
+	}
+
+	remote := "https://github.com/our-org/test-git-flags.git"
+	cmd = exec.Command(binary, "--template=minimal", "--git-branch=trunk", "--git-remote="+remote,
+		"--git-author=Build Bot <bot@example.com>", "--install-hooks", "test-git-flags")
+	cmd.Dir = dir
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git flags error = %v\n%s", err, output)
+	}
+	if !strings.Contains(string(output), remote+" (not pushed)") {
+		t.Errorf("output should mention the remote:\n%s", output)
+	}
+	projectDir := filepath.Join(dir, "test-git-flags")
+	git := exec.Command("git", "log", "-1", "--format=%D|%an")
+	git.Dir = projectDir
+	log, err := git.Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.TrimSpace(string(log)); got != "HEAD -> trunk|Build Bot" {
+		t.Errorf("initial commit = %q, want it on trunk by Build Bot", got)
+	}
+	if _, err := os.Stat(filepath.Join(projectDir, ".git", "hooks", "pre-push")); err != nil {
+		t.Errorf("--install-hooks should install pre-push: %v", err)
+	}
+
+	cmd = exec.Command(binary, "--git-branch=my branch", "test-git-invalid")
+	cmd.Dir = dir
+	output, _ = cmd.CombinedOutput()
+	if cmd.ProcessState.ExitCode() != failureValidation.ExitCode || !strings.Contains(string(output), "invalid git branch name") {
+		t.Errorf("invalid --git-branch exit code = %d, output:\n%s", cmd.ProcessState.ExitCode(), output)
+	}
+}
+
+// unsetGitIdentity leaves git without user.name and user.email for the rest
+// of the test
+func unsetGitIdentity(t *testing.T) {
+	t.Helper()
+	for _, name := range []string{"GIT_AUTHOR_NAME", "GIT_AUTHOR_EMAIL", "GIT_COMMITTER_NAME", "GIT_COMMITTER_EMAIL", "EMAIL"} {
+		t.Setenv(name, "")
+		os.Unsetenv(name)
+	}
+	t.Setenv("GIT_CONFIG_GLOBAL", os.DevNull)
+	t.Setenv("GIT_CONFIG_NOSYSTEM", "1")
+	t.Setenv("GIT_CONFIG_COUNT", "1")
+	t.Setenv("GIT_CONFIG_KEY_0", "user.useConfigOnly")
+	t.Setenv("GIT_CONFIG_VALUE_0", "true")
+}
+
+// TestInitProjectRepoMissingIdentity tests that a missing git identity is
+// explained, with the commands to fix it
+func TestInitProjectRepoMissingIdentity(t *testing.T) {
+	if !generator.IsGitAvailable() {
+		t.Skip("git is not installed")
+	}
+	unsetGitIdentity(t)
+
+	opts := testProjectOptions("identity-app", generator.TemplateMinimal)
+	opts.Git.Branch = "main"
+	report := newGenerationReport(opts)
+	var out strings.Builder
+	initProjectRepo(&out, report, opts, t.TempDir())
+
+	if report.Git.Initialized || report.Git.Error == "" || len(report.Warnings) != 1 {
+		t.Errorf("git report = %+v, warnings = %v", report.Git, report.Warnings)
+	}
+	if err := checkGitRepo(report, opts); failureOf(err) != failureGit {
+		t.Errorf("checkGitRepo() with --git-branch = %v, want a git failure", err)
+	}
+	opts.Git.Branch = ""
+	if err := checkGitRepo(report, opts); err != nil {
+		t.Errorf("checkGitRepo() with the default git options = %v, want a warning only", err)
+	}
+	for _, want := range []string{"git config --global user.email", "--git-author", "git init -b main"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output should contain %q:\n%s", want, out.String())
+		}
+	}
+	if strings.Contains(out.String(), "***") {
+		t.Errorf("output should not dump the git output:\n%s", out.String())
+	}
+}
+
+// TestMissingGitIdentityExitCode tests through the CLI binary that a project
+// generated without its repository exits with status 0 after a warning,
+// unless the repository was set up by the git options
+func TestMissingGitIdentityExitCode(t *testing.T) {
+	if !generator.IsGitAvailable() {
+		t.Skip("git is not installed")
+	}
+	binary, err := filepath.Abs(binaryPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unsetGitIdentity(t)
+	dir := t.TempDir()
+
+	cmd := exec.Command(binary, "--template=minimal", "no-identity")
+	cmd.Dir = dir
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("generation without git identity should succeed, got %v:\n%s", err, output)
+	}
+	for _, want := range []string{"Git does not know who you are", "created successfully"} {
+		if !strings.Contains(string(output), want) {
+			t.Errorf("output should contain %q:\n%s", want, output)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "no-identity", ".git")); !os.IsNotExist(err) {
+		t.Errorf("no repository should be initialized, stat error = %v", err)
+	}
+
+	cmd = exec.Command(binary, "--template=minimal", "--git-branch=main", "no-identity-branch")
+	cmd.Dir = dir
+	output, _ = cmd.CombinedOutput()
+	if code := cmd.ProcessState.ExitCode(); code != failureGit.ExitCode {
+		t.Errorf("exit code with --git-branch = %d, want %d:\n%s", code, failureGit.ExitCode, output)
+	}
+	if !strings.Contains(string(output), "git repository was not initialized") || strings.Contains(string(output), "created successfully") {
+		t.Errorf("output should report the git failure instead of a success:\n%s", output)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "no-identity-branch", "go.mod")); err != nil {
+		t.Errorf("the project should be kept: %v", err)
+	}
+}
+
+// TestE2EGitIntegration is an end-to-end test that verifies the full CLI
+// creates a project with git initialization (AC: 1, 2, 3, 5)
+func TestE2EGitIntegration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping E2E test in short mode")
+	}
+	if !generator.IsGitAvailable() {
+		t.Skip("git not installed, skipping E2E test")
+	}
+	binary, err := filepath.Abs(binaryPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	projectName := "test-git-e2e"
+
+	cmd := exec.Command(binary, projectName)
+	cmd.Dir = dir
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("CLI execution failed: %v\nOutput: %s", err, string(output))
+	}
+
+	// Verify output mentions git initialization
+	outputStr := string(output)
+	if !strings.Contains(outputStr, "Initializing Git repository") {
+		t.Error("Expected git initialization message in output")
+	}
+	if !strings.Contains(outputStr, "Git repository initialized") {
+		t.Error("Expected git success message in output")
+	}
+
+	// AC1: Verify .git directory exists
+	projectDir := filepath.Join(dir, projectName)
+	if _, err := os.Stat(filepath.Join(projectDir, ".git")); os.IsNotExist(err) {
+		t.Error("AC1 FAILED: .git directory was not created")
+	}
+
+	// AC2 & AC3: Verify initial commit exists with correct message
+	cmd = exec.Command("git", "log", "--format=%s", "-1")
+	cmd.Dir = projectDir
+	commitOutput, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("Failed to get git log: %v", err)
+	}
+	expectedMsg := "Initial commit from go-starter-kit"
+	if !strings.Contains(string(commitOutput), expectedMsg) {
+		t.Errorf("AC2 FAILED: Expected commit message %q, got %q", expectedMsg, string(commitOutput))
+	}
+
+	// AC3 & AC5: Verify the generated files are tracked, so git init ran after
+	// file generation
+	cmd = exec.Command("git", "ls-files")
+	cmd.Dir = projectDir
+	filesOutput, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("Failed to list git files: %v", err)
+	}
+	trackedFiles := string(filesOutput)
+	for _, f := range []string{"go.mod", "Makefile", "README.md", ".env.example"} {
+		if !strings.Contains(trackedFiles, f) {
+			t.Errorf("AC3 FAILED: Expected file %q to be tracked in git", f)
+		}
+	}
+}
+
+// TestRunInterruptibleAfterSuccess tests that a signal arriving once fn has
+// done its work is not reported as an interruption
+func TestRunInterruptibleAfterSuccess(t *testing.T) {
+	var signalErr error
+	err := runInterruptible(func(ctx context.Context) error {
+		p, err := os.FindProcess(os.Getpid())
+		if err == nil {
+			err = p.Signal(os.Interrupt)
+		}
+		if signalErr = err; err != nil {
+			return nil
+		}
+		<-ctx.Done()
+		return nil
+	})
+	if signalErr != nil {
+		t.Skipf("cannot send an interrupt: %v", signalErr)
+	}
+	if err != nil {
+		t.Errorf("runInterruptible() error = %v, want the result of fn", err)
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tky0065/go-starter-kit/pkg/generator"
+)
+
+// testProjectOptions returns the default options for the given project name and template
+func testProjectOptions(projectName, template string) generator.ProjectOptions {
+	opts := generator.DefaultProjectOptions()
+	opts.ProjectName = projectName
+	opts.Template = template
+	return opts
+}
+
+// writeTestProject writes the files generated with opts into a temporary
+// directory, without running the post-generation steps
+func writeTestProject(t *testing.T, opts generator.ProjectOptions) string {
+	t.Helper()
+	projectPath := filepath.Join(t.TempDir(), opts.ProjectName)
+	files, err := generator.ProjectFiles(opts)
+	if err != nil {
+		t.Fatalf("ProjectFiles() error = %v", err)
+	}
+	sink := generator.NewDirSink(projectPath)
+	for _, file := range files {
+		if err := sink.WriteFile(filepath.ToSlash(file.Path), []byte(file.Content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return projectPath
+}
+
+// TestModuleFlag tests that --module is used for go.mod and imports while the
+// directory and binary keep the project name
+func TestModuleFlag(t *testing.T) {
+	testProjectName := "test-module-flag"
+	defer os.RemoveAll(testProjectName)
+
+	cmd := exec.Command(binaryPath, "--module", "github.com/our-org/test-module-flag", testProjectName)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("Expected successful execution, got error: %v\nOutput: %s", err, string(output))
+	}
+
+	checks := map[string][]string{
+		"go.mod":      {"module github.com/our-org/test-module-flag\n"},
+		"cmd/main.go": {`"github.com/our-org/test-module-flag/internal/infrastructure/server"`},
+		"Dockerfile":  {"-o test-module-flag ./cmd", `CMD ["./test-module-flag"]`},
+		"Makefile":    {"BINARY_NAME=test-module-flag"},
+	}
+	for file, wants := range checks {
+		content, err := os.ReadFile(filepath.Join(testProjectName, file))
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", file, err)
+		}
+		for _, want := range wants {
+			if !strings.Contains(string(content), want) {
+				t.Errorf("%s should contain %q", file, want)
+			}
+		}
+	}
+}
+
+// TestInvalidModuleFlag tests that an invalid --module value is rejected
+func TestInvalidModuleFlag(t *testing.T) {
+	cmd := exec.Command(binaryPath, "--module", "not a module", "test-invalid-module")
+	output, err := cmd.CombinedOutput()
+	if err == nil {
+		os.RemoveAll("test-invalid-module")
+		t.Fatal("Expected error for invalid module path")
+	}
+	if !strings.Contains(string(output), "invalid module path") {
+		t.Errorf("Expected 'invalid module path' error, got: %s", string(output))
+	}
+}
@@ -0,0 +1,265 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tky0065/go-starter-kit/pkg/generator"
+)
+
+// Output formats accepted by the --output flag
+const (
+	OutputText = "text"
+	OutputJSON = "json"
+)
+
+// ValidOutputFormats lists the values of the --output flag
+var ValidOutputFormats = []string{OutputText, OutputJSON}
+
+// validateOutputFormat checks the value of the --output flag
+func validateOutputFormat(format string) error {
+	if format != OutputText && format != OutputJSON {
+		return fmt.Errorf("invalid output format '%s': valid options are: %s, %s", format, OutputText, OutputJSON)
+	}
+	return nil
+}
+
+// failureClass groups the errors of project generation that scripts may want
+// to tell apart, each with its own exit code
+type failureClass struct {
+	// Name identifies the class in the JSON report
+	Name string `json:"class"`
+	// ExitCode is the process exit code of the class
+	ExitCode int `json:"exit_code"`
+}
+
+// Failure classes of project generation. Errors that are not classified exit
+// with status 1, like the subcommands.
+var (
+	failureOther           = failureClass{"error", 1}
+	failureValidation      = failureClass{"validation", 2}
+	failureDirectoryExists = failureClass{"directory_exists", 3}
+	failureWrite           = failureClass{"write", 4}
+	failureGit             = failureClass{"git", 5}
+	failureVerification    = failureClass{"verification", 6}
+	failureHook            = failureClass{"hook", 7}
+)
+
+// classifiedError is an error tagged with its failure class
+type classifiedError struct {
+	class failureClass
+	err   error
+}
+
+func (e *classifiedError) Error() string { return e.err.Error() }
+func (e *classifiedError) Unwrap() error { return e.err }
+
+// classify tags err with the failure class; it returns nil for a nil err
+func classify(class failureClass, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &classifiedError{class, err}
+}
+
+// classifyGeneration tags an error of generator.Create with its failure class
+func classifyGeneration(err error) error {
+	switch {
+	case errors.Is(err, generator.ErrInvalidOptions):
+		return classify(failureValidation, err)
+	case errors.Is(err, generator.ErrProjectExists):
+		return classify(failureDirectoryExists, err)
+	case errors.Is(err, generator.ErrWrite):
+		return classify(failureWrite, err)
+	case errors.Is(err, generator.ErrHook):
+		return classify(failureHook, err)
+	}
+	return err
+}
+
+// failureOf returns the failure class of err, failureOther if unclassified
+func failureOf(err error) failureClass {
+	var ce *classifiedError
+	if errors.As(err, &ce) {
+		return ce.class
+	}
+	return failureOther
+}
+
+// generatedEntry is a directory or file of the generated project
+type generatedEntry struct {
+	// Path is relative to the project root, with forward slashes
+	Path string `json:"path"`
+	// Size is the file size in bytes
+	Size int64 `json:"size"`
+	// SHA256 is the hex-encoded hash of the file content
+	SHA256 string `json:"sha256"`
+}
+
+// gitReport describes the git repository initialization
+type gitReport struct {
+	// Requested reports whether git initialization was enabled
+	Requested bool `json:"requested"`
+	// Initialized reports whether the repository and its initial commit were created
+	Initialized bool `json:"initialized"`
+	// Branch is the branch of the initial commit, when set with --git-branch
+	Branch string `json:"branch,omitempty"`
+	// Remote is the URL recorded as the origin remote
+	Remote string `json:"remote,omitempty"`
+	// HooksInstalled reports whether the --install-hooks git hooks were installed
+	HooksInstalled bool `json:"hooks_installed"`
+	// Error is the message of a failed initialization
+	Error string `json:"error,omitempty"`
+}
+
+// reportError describes the error that stopped the generation
+type reportError struct {
+	failureClass
+	// Message is the error message
+	Message string `json:"message"`
+}
+
+// generationReport is the JSON document printed by --output=json
+type generationReport struct {
+	// OK reports whether the project was generated
+	OK bool `json:"ok"`
+	// Project is the project directory
+	Project string `json:"project,omitempty"`
+	// Options are the resolved project options
+	Options generator.ProjectOptions `json:"options"`
+	// Directories lists the created directories, relative to the project root
+	Directories []string `json:"directories"`
+	// Files lists the created files, relative to the project root
+	Files []generatedEntry `json:"files"`
+	// Git describes the git repository initialization
+	Git gitReport `json:"git"`
+	// Verification is the --verify report, when requested
+	Verification *verifyReport `json:"verification,omitempty"`
+	// Warnings lists the non-fatal problems, such as a git or hook failure
+	Warnings []string `json:"warnings"`
+	// NextSteps lists the commands to run to start the project
+	NextSteps []string `json:"next_steps"`
+	// Error is the error that stopped the generation
+	Error *reportError `json:"error,omitempty"`
+}
+
+// newGenerationReport returns an empty report for opts
+func newGenerationReport(opts generator.ProjectOptions) *generationReport {
+	return &generationReport{
+		Options:     opts.WithDefaults(),
+		Directories: []string{},
+		Files:       []generatedEntry{},
+		Git:         gitReport{Requested: opts.Git.Init, Branch: opts.Git.Branch, Remote: opts.Git.Remote},
+		Warnings:    []string{},
+		NextSteps:   []string{},
+	}
+}
+
+// recordWarnings returns a generator.Progress adding the warnings of the
+// hooks to the report, then passing the events on to progress
+func (r *generationReport) recordWarnings(progress generator.Progress) generator.Progress {
+	return func(e generator.Event) {
+		if e.Warning != "" {
+			r.Warnings = append(r.Warnings, e.Warning)
+		}
+		progress(e)
+	}
+}
+
+// fail records err in the report
+func (r *generationReport) fail(err error) {
+	r.OK = false
+	r.Error = &reportError{failureOf(err), err.Error()}
+}
+
+// exitCode returns the process exit code of the report: the class of its
+// error, or 0. A project generated without its git repository only has a
+// warning, unless checkGitRepo failed it.
+func (r *generationReport) exitCode() int {
+	if r.Error != nil {
+		return r.Error.ExitCode
+	}
+	return 0
+}
+
+// write prints the report as indented JSON
+func (r *generationReport) write(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// inventory fills the directories and files of the report by walking the
+// project in projectPath, skipping the .git directory
+func (r *generationReport) inventory(projectPath string) error {
+	return filepath.WalkDir(projectPath, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(projectPath, p)
+		if err != nil || rel == "." {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if d.IsDir() {
+			if d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			r.Directories = append(r.Directories, rel)
+			return nil
+		}
+		content, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		sum := sha256.Sum256(content)
+		r.Files = append(r.Files, generatedEntry{Path: rel, Size: int64(len(content)), SHA256: hex.EncodeToString(sum[:])})
+		return nil
+	})
+}
+
+// recordingSink passes the project on to a Sink and lists its directories and
+// files in a report, like inventory does for a project on disk
+type recordingSink struct {
+	generator.Sink
+	report *generationReport
+}
+
+// isGitPath reports whether the slash-separated path p is in the .git directory
+func isGitPath(p string) bool {
+	return p == ".git" || strings.HasPrefix(p, ".git/")
+}
+
+func (s recordingSink) Mkdir(dir string) error {
+	if err := s.Sink.Mkdir(dir); err != nil {
+		return err
+	}
+	if !isGitPath(dir) {
+		s.report.Directories = append(s.report.Directories, dir)
+	}
+	return nil
+}
+
+func (s recordingSink) WriteFile(name string, content []byte, perm fs.FileMode) error {
+	if err := s.Sink.WriteFile(name, content, perm); err != nil {
+		return err
+	}
+	if !isGitPath(name) {
+		sum := sha256.Sum256(content)
+		s.report.Files = append(s.report.Files, generatedEntry{Path: name, Size: int64(len(content)), SHA256: hex.EncodeToString(sum[:])})
+	}
+	return nil
+}
+
+// nextSteps returns the commands to get the generated project running
+func nextSteps(projectName string) []string {
+	return []string{"cd " + projectName, "./setup.sh", "make run"}
+}
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"slices"
+	"testing"
+
+	"github.com/tky0065/go-starter-kit/pkg/generator"
+)
+
+func TestFailureOf(t *testing.T) {
+	err := classify(failureWrite, errors.New("disk full"))
+	if got := failureOf(err); got != failureWrite {
+		t.Errorf("failureOf() = %v, want %v", got, failureWrite)
+	}
+	if got := failureOf(fmt.Errorf("wrapped: %w", err)); got != failureWrite {
+		t.Errorf("failureOf(wrapped) = %v, want %v", got, failureWrite)
+	}
+	if got := failureOf(errors.New("plain")); got != failureOther {
+		t.Errorf("failureOf(unclassified) = %v, want %v", got, failureOther)
+	}
+	if classify(failureGit, nil) != nil {
+		t.Error("classify(nil) should be nil")
+	}
+}
+
+func TestGenerationReportExitCode(t *testing.T) {
+	report := newGenerationReport(testProjectOptions("exit-code", generator.TemplateMinimal))
+	report.OK, report.Git.Initialized = true, true
+	if got := report.exitCode(); got != 0 {
+		t.Errorf("exitCode() = %d, want 0", got)
+	}
+
+	report.Git.Initialized = false
+	if got := report.exitCode(); got != 0 {
+		t.Errorf("exitCode() without git repository = %d, want 0: the failure is a warning", got)
+	}
+
+	report.fail(classify(failureDirectoryExists, errors.New("taken")))
+	if got := report.exitCode(); got != failureDirectoryExists.ExitCode {
+		t.Errorf("exitCode() = %d, want %d", got, failureDirectoryExists.ExitCode)
+	}
+	if report.OK || report.Error.Name != "directory_exists" || report.Error.Message != "taken" {
+		t.Errorf("report error = %+v", report.Error)
+	}
+}
+
+func TestGenerateProjectReport(t *testing.T) {
+	t.Chdir(t.TempDir())
+	opts := testProjectOptions("report-project", generator.TemplateMinimal)
+	opts.Git.Init = false
+
+	report, err := generateProject(io.Discard, opts)
+	if err != nil {
+		t.Fatalf("generateProject() error = %v", err)
+	}
+	if !report.OK || report.Project != "report-project" || report.exitCode() != 0 {
+		t.Errorf("report = %+v", report)
+	}
+	if !slices.Contains(report.Directories, "internal/adapters/http") {
+		t.Errorf("directories = %v, want internal/adapters/http", report.Directories)
+	}
+
+	// The report lists the files on disk, including .env copied after generation
+	for _, name := range []string{"go.mod", ".env", generator.ManifestPath} {
+		i := slices.IndexFunc(report.Files, func(f generatedEntry) bool { return f.Path == name })
+		if i < 0 {
+			t.Errorf("files should list %s", name)
+			continue
+		}
+		content, err := os.ReadFile("report-project/" + name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sum := sha256.Sum256(content)
+		if f := report.Files[i]; f.Size != int64(len(content)) || f.SHA256 != hex.EncodeToString(sum[:]) {
+			t.Errorf("%s entry = %+v, want size %d", name, f, len(content))
+		}
+	}
+	if report.Git.Requested || report.Git.Initialized {
+		t.Errorf("git = %+v, want neither requested nor initialized", report.Git)
+	}
+	if !slices.Contains(report.NextSteps, "cd report-project") {
+		t.Errorf("next steps = %v", report.NextSteps)
+	}
+
+	report, err = generateProject(io.Discard, opts)
+	if failureOf(err) != failureDirectoryExists {
+		t.Errorf("second generateProject() error = %v, want directory_exists", err)
+	}
+	if report.OK || len(report.Files) != 0 {
+		t.Errorf("report of a failed generation = %+v", report)
+	}
+}
+
+// TestGenerateProjectHooks tests that hook failures are reported like the git
+// step: as a warning or as a failure of their own class
+func TestGenerateProjectHooks(t *testing.T) {
+	if _, err := exec.LookPath("false"); err != nil {
+		t.Skip("false is not available")
+	}
+	t.Chdir(t.TempDir())
+	opts := testProjectOptions("hooks-project", generator.TemplateMinimal)
+	opts.Git.Init = false
+	opts.Hooks.Post = []generator.Hook{{Name: "register", Command: []string{"false"}, OnFailure: generator.HookWarn}}
+
+	report, err := generateProject(io.Discard, opts)
+	if err != nil {
+		t.Fatalf("generateProject() error = %v", err)
+	}
+	if !report.OK || report.exitCode() != 0 || len(report.Warnings) != 1 {
+		t.Errorf("report = %+v, want one warning", report)
+	}
+
+	opts.ProjectName = "hooks-fatal"
+	opts.Hooks.Post[0].OnFailure = ""
+	report, err = generateProject(io.Discard, opts)
+	if failureOf(err) != failureHook {
+		t.Errorf("generateProject() error = %v, want hook", err)
+	}
+	if _, err := os.Stat("hooks-fatal"); !os.IsNotExist(err) {
+		t.Error("a failing hook should leave nothing behind")
+	}
+	if report.OK {
+		t.Errorf("report = %+v", report)
+	}
+}
+
+// TestOutputJSONFlag tests --output=json and the exit codes through the CLI binary
+func TestOutputJSONFlag(t *testing.T) {
+	testProjectName := "test-output-json"
+	defer os.RemoveAll(testProjectName)
+
+	tests := []struct {
+		name     string
+		args     []string
+		wantCode int
+		wantOK   bool
+	}{
+		{"generated", []string{"--template=minimal", testProjectName}, 0, true},
+		{"directory exists", []string{"--template=minimal", testProjectName}, failureDirectoryExists.ExitCode, false},
+		{"invalid name", []string{"invalid name!"}, failureValidation.ExitCode, false},
+		{"invalid framework", []string{"--framework=martini", "test-output-invalid"}, failureValidation.ExitCode, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := exec.Command(binaryPath, append([]string{"--output=json"}, tt.args...)...)
+			output, err := cmd.Output()
+			code := 0
+			var exitErr *exec.ExitError
+			if errors.As(err, &exitErr) {
+				code = exitErr.ExitCode()
+			} else if err != nil {
+				t.Fatal(err)
+			}
+
+			var report generationReport
+			if err := json.Unmarshal(output, &report); err != nil {
+				t.Fatalf("output is not a JSON report: %v\n%s", err, output)
+			}
+			if tt.wantCode == 0 && report.exitCode() == failureGit.ExitCode {
+				t.Skip("git repository not initialized in this environment")
+			}
+			if code != tt.wantCode || report.OK != tt.wantOK {
+				t.Errorf("exit code = %d, ok = %v, want %d, %v\n%s", code, report.OK, tt.wantCode, tt.wantOK, output)
+			}
+			if tt.wantOK && len(report.Files) == 0 {
+				t.Error("report should list the generated files")
+			}
+			if !tt.wantOK && (report.Error == nil || report.Error.ExitCode != code) {
+				t.Errorf("report error = %+v, want exit code %d", report.Error, code)
+			}
+		})
+	}
+
+	output, err := exec.Command(binaryPath, "--output=json", "--dry-run", "test-output-dry-run").Output()
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) || exitErr.ExitCode() != failureValidation.ExitCode {
+		t.Errorf("--output=json --dry-run = %v, want exit code %d\n%s", err, failureValidation.ExitCode, output)
+	}
+}
@@ -1,11 +1,14 @@
 package main
 
 import (
+	"context"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/tky0065/go-starter-kit/pkg/generator"
 )
 
 // TestE2ESmokeTestValidation performs comprehensive smoke test validation
@@ -26,14 +29,10 @@ func TestE2ESmokeTestValidation(t *testing.T) {
 
 	// AC#1: Test project generation without errors
 	t.Run("AC1_ProjectGeneration", func(t *testing.T) {
-		// Create project structure (using full template)
-		if err := createProjectStructure(projectPath, TemplateFull); err != nil {
-			t.Fatalf("Failed to create project structure: %v", err)
-		}
-
-		// Generate project files
-		if err := generateProjectFiles(projectPath, projectName, DefaultTemplate); err != nil {
-			t.Fatalf("Failed to generate project files: %v", err)
+		// Generate the project (using full template)
+		opts := testProjectOptions(projectName, generator.TemplateFull)
+		if err := generator.Create(context.Background(), opts, projectPath, nil); err != nil {
+			t.Fatalf("Failed to generate project: %v", err)
 		}
 
 		t.Log("✅ AC#1: Project generated without errors")
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/tky0065/go-starter-kit/pkg/generator"
+)
+
+// loadOverridesDir returns the override files of the --overrides directory,
+// which must exist
+func loadOverridesDir(dir string) (fs.FS, error) {
+	overrides, err := generator.LoadOverrides(dir)
+	if err == nil && overrides == nil {
+		err = fmt.Errorf("%s not found", dir)
+	}
+	return overrides, err
+}
+
+// varsFlag implements flag.Value for --var, which can be repeated to set
+// several template variables (--var team=platform --var tier=gold)
+type varsFlag map[string]string
+
+func (f varsFlag) String() string {
+	pairs := make([]string, 0, len(f))
+	for _, name := range slices.Sorted(maps.Keys(f)) {
+		pairs = append(pairs, name+"="+f[name])
+	}
+	return strings.Join(pairs, ",")
+}
+
+func (f varsFlag) Set(value string) error {
+	name, val, ok := strings.Cut(value, "=")
+	if !ok || name == "" {
+		return fmt.Errorf("invalid variable '%s': use name=value", value)
+	}
+	f[name] = val
+	return nil
+}
+
+// registerTemplateDir loads the template pack in dir and registers it, so that
+// it can be selected by name like a built-in template. It returns the name of
+// the template.
+func registerTemplateDir(dir string) (string, error) {
+	tmpl, err := generator.LoadTemplateDir(dir)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := generator.LookupTemplate(tmpl.Name()); ok {
+		return "", fmt.Errorf("template pack %s: template '%s' already exists, choose another name in %s", dir, tmpl.Name(), generator.TemplatePackManifest)
+	}
+	generator.RegisterTemplate(tmpl)
+	return tmpl.Name(), nil
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tky0065/go-starter-kit/pkg/generator"
+)
+
+func TestVarsFlag(t *testing.T) {
+	vars := varsFlag{}
+	for _, value := range []string{"team=platform", "image=golang:1.25=alpine", "empty="} {
+		if err := vars.Set(value); err != nil {
+			t.Errorf("Set(%q) error = %v", value, err)
+		}
+	}
+	if got, want := vars.String(), "empty=,image=golang:1.25=alpine,team=platform"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	for _, value := range []string{"team", "=platform"} {
+		if err := vars.Set(value); err == nil {
+			t.Errorf("Set(%q) should fail", value)
+		}
+	}
+}
+
+// TestTemplateDirFlag tests --template-dir and --var through the CLI binary
+func TestTemplateDirFlag(t *testing.T) {
+	packDir := t.TempDir()
+	files := map[string]string{
+		generator.TemplatePackManifest: "name: test-pack\ndirectories: [cmd]\nvariables:\n  team: platform\n",
+		"files/go.mod.tmpl":            "module {{.ModulePath}}\n",
+		"files/cmd/main.go.tmpl":       "package main\n\n// Owned by {{.Vars.team}}\nfunc main() {}\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(packDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	binary, err := filepath.Abs(binaryPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command(binary, "--template-dir", packDir, "--var", "team=payments", "test-template-dir")
+	cmd.Dir = t.TempDir()
+	if output, err := cmd.CombinedOutput(); err != nil && !strings.Contains(string(output), "Git") {
+		t.Fatalf("--template-dir error = %v\n%s", err, output)
+	}
+	content, err := os.ReadFile(filepath.Join(cmd.Dir, "test-template-dir", "cmd", "main.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(content), "Owned by payments") {
+		t.Errorf("cmd/main.go = %s", content)
+	}
+}
+
+// TestOverridesFlag tests that overrides are only applied with --overrides,
+// not picked up from the current directory
+func TestOverridesFlag(t *testing.T) {
+	binary, err := filepath.Abs(binaryPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	override := filepath.Join(dir, filepath.FromSlash(generator.OverridesDir), "README.md.tmpl")
+	if err := os.MkdirAll(filepath.Dir(override), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(override, []byte("# {{.ProjectName}} overridden\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	readme := func(project string) string {
+		content, err := os.ReadFile(filepath.Join(dir, project, "README.md"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(content)
+	}
+
+	for project, args := range map[string][]string{
+		"implicit-overrides": nil,
+		"explicit-overrides": {"--overrides", generator.OverridesDir},
+	} {
+		cmd := exec.Command(binary, append(append([]string{"--template=minimal", "--no-git"}, args...), project)...)
+		cmd.Dir = dir
+		if output, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("%s error = %v\n%s", project, err, output)
+		}
+	}
+	if strings.Contains(readme("implicit-overrides"), "overridden") {
+		t.Error("overrides of the current directory should not apply without --overrides")
+	}
+	if got := readme("explicit-overrides"); got != "# explicit-overrides overridden\n" {
+		t.Errorf("README.md with --overrides = %q", got)
+	}
+
+	cmd := exec.Command(binary, "--template=minimal", "--no-git", "--overrides", "missing", "missing-overrides")
+	cmd.Dir = dir
+	output, _ := cmd.CombinedOutput()
+	if cmd.ProcessState.ExitCode() != failureValidation.ExitCode || !strings.Contains(string(output), "missing not found") {
+		t.Errorf("--overrides with a missing directory exit code = %d, output:\n%s", cmd.ProcessState.ExitCode(), output)
+	}
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/tky0065/go-starter-kit/pkg/generator"
+)
+
+// upgradeCommand re-renders an existing project with the current templates
+var upgradeCommand = &Command{
+	Name:        "upgrade",
+	Usage:       "upgrade [--dir <project>] [--dry-run] [--template-dir <pack>]",
+	Description: "Update the project to the current templates, merging your changes",
+}
+
+// Run is set in init as runUpgrade refers to upgradeCommand for its usage
+func init() {
+	upgradeCommand.Run = runUpgrade
+}
+
+// runUpgrade parses the upgrade arguments, then upgrades the project and
+// reports what changed
+func runUpgrade(args []string) error {
+	flags := flag.NewFlagSet("upgrade", flag.ContinueOnError)
+	dir := flags.String("dir", ".", "Directory of the project to upgrade")
+	dryRun := flags.Bool("dry-run", false, "Show what would change without writing anything")
+	templateDir := flags.String("template-dir", "", "Template pack the project was generated from, if not a built-in template")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: create-go-starter %s\n\n", upgradeCommand.Usage)
+		fmt.Fprintf(flags.Output(), "Run inside a project generated by create-go-starter. Commit your changes first\nso the upgrade can be reviewed with git diff.\n\nOptions:\n")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() > 0 {
+		return fmt.Errorf("unexpected argument '%s'", flags.Arg(0))
+	}
+
+	if *templateDir != "" {
+		if _, err := registerTemplateDir(*templateDir); err != nil {
+			return err
+		}
+	}
+
+	result, err := generator.Upgrade(*dir, *dryRun)
+	if err != nil {
+		return err
+	}
+
+	if *dryRun {
+		fmt.Println(Green("Dry run: nothing will be written"))
+	}
+	fmt.Println(Green(fmt.Sprintf("Upgrading templates %s (create-go-starter %s) to %s (create-go-starter %s)",
+		result.FromTemplates, result.FromVersion, generator.TemplatesVersion(), generator.Version)))
+	for _, p := range result.Updated {
+		fmt.Println(Green("✅ Updated " + p))
+	}
+	for _, p := range result.Added {
+		fmt.Println(Green("✅ Added " + p))
+	}
+	for _, p := range result.Removed {
+		fmt.Println(Green("✅ Removed " + p))
+	}
+	for _, p := range result.Merged {
+		fmt.Println(Green("✅ Merged " + p + " (your changes were kept)"))
+	}
+	for _, p := range result.Kept {
+		fmt.Println("⏭️  Kept " + p + " (modified locally, no longer generated)")
+	}
+	for _, p := range result.Skipped {
+		fmt.Println("⏭️  Skipped " + p + " (deleted locally)")
+	}
+	for _, p := range result.Conflicted {
+		fmt.Println(Red("⚠️  Conflict in " + p))
+	}
+	if !result.Changed() {
+		fmt.Println(Green("✅ Project is up to date"))
+		return nil
+	}
+	if *dryRun {
+		return nil
+	}
+
+	fmt.Println()
+	fmt.Println("📋 Next steps:")
+	fmt.Println("  git diff        # Review the changes")
+	fmt.Println("  go mod tidy")
+	fmt.Println("  go build ./... && go test ./...")
+
+	if n := len(result.Conflicted); n > 0 {
+		return fmt.Errorf("%d file(s) with conflicts: resolve the <<<<<<< markers before building", n)
+	}
+	return nil
+}
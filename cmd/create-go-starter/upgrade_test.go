@@ -0,0 +1,27 @@
+package main
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/tky0065/go-starter-kit/pkg/generator"
+)
+
+// TestUpgradeCommand tests upgrade through the CLI binary
+func TestUpgradeCommand(t *testing.T) {
+	projectPath := writeTestProject(t, testProjectOptions("cli-upgrade", generator.TemplateMinimal))
+
+	output, err := exec.Command(binaryPath, "upgrade", "--dir", projectPath).CombinedOutput()
+	if err != nil {
+		t.Fatalf("Expected successful execution, got error: %v\nOutput: %s", err, output)
+	}
+	if !strings.Contains(string(output), "Project is up to date") {
+		t.Errorf("output should report the project is up to date, got:\n%s", output)
+	}
+
+	output, err = exec.Command(binaryPath, "upgrade", "--dir", t.TempDir()).CombinedOutput()
+	if err == nil || !strings.Contains(string(output), "no .go-starter.json found") {
+		t.Errorf("upgrade without a manifest = %v, output:\n%s", err, output)
+	}
+}
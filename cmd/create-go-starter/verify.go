@@ -0,0 +1,127 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/tky0065/go-starter-kit/pkg/generator"
+)
+
+// verifyStage is a go command run in the generated project by --verify
+type verifyStage struct {
+	// Name is the command line shown in the report
+	Name string
+	// Args are the arguments of the go command
+	Args []string
+	// Hint tells how to fix a failure of the stage
+	Hint string
+}
+
+// brokenTemplateHint is the hint of the stages that only fail on a broken template
+const brokenTemplateHint = "The generated code is broken: please report it at https://github.com/tky0065/go-starter-kit/issues with the output above"
+
+// verifyStages returns the stages run by --verify for the given template. The
+// graphql template generates its gqlgen code before it is vetted and built.
+func verifyStages(template string) []verifyStage {
+	stages := []verifyStage{{
+		Name: "go mod tidy",
+		Args: []string{"mod", "tidy"},
+		Hint: "Check access to the module proxy, or set GOPROXY to a reachable proxy (GOPROXY=off resolves from the module cache only, for offline use)",
+	}}
+	if template == generator.TemplateGraphQL {
+		stages = append(stages, verifyStage{
+			Name: "go generate ./...",
+			Args: []string{"generate", "./..."},
+			Hint: "gqlgen failed: check graph/schema.graphqls and gqlgen.yml",
+		})
+	}
+	return append(stages,
+		verifyStage{Name: "go vet ./...", Args: []string{"vet", "./..."}, Hint: brokenTemplateHint},
+		verifyStage{Name: "go build ./...", Args: []string{"build", "./..."}, Hint: brokenTemplateHint},
+		verifyStage{Name: "go test ./...", Args: []string{"test", "./..."}, Hint: brokenTemplateHint},
+	)
+}
+
+// verifyResult is the outcome of a verification stage
+type verifyResult struct {
+	// Stage is the command line of the stage
+	Stage string `json:"stage"`
+	// Status is pass, fail or skip (an earlier stage failed)
+	Status string `json:"status"`
+	// DurationMS is the time the stage took, in milliseconds
+	DurationMS int64 `json:"duration_ms"`
+	// Output is the combined output of a failed stage
+	Output string `json:"output,omitempty"`
+	// Hint tells how to fix a failed stage
+	Hint string `json:"hint,omitempty"`
+}
+
+// verifyReport is the result of --verify
+type verifyReport struct {
+	// OK reports whether every stage passed
+	OK bool `json:"ok"`
+	// Stages lists the stages in the order they ran
+	Stages []verifyResult `json:"stages"`
+}
+
+// failed returns the stage that failed, or nil
+func (r *verifyReport) failed() *verifyResult {
+	for i := range r.Stages {
+		if r.Stages[i].Status == checkFail {
+			return &r.Stages[i]
+		}
+	}
+	return nil
+}
+
+// verifyProject runs the stages in projectPath, printing each result to w as
+// it completes. The stages after a failed one are skipped. The go commands use
+// the environment of the user (GOPROXY, GOFLAGS, module cache) outside of any
+// go.work workspace.
+func verifyProject(w io.Writer, projectPath string, stages []verifyStage) *verifyReport {
+	report := &verifyReport{OK: true}
+	for _, stage := range stages {
+		result := verifyResult{Stage: stage.Name, Status: checkSkip}
+		if report.OK {
+			cmd := exec.Command("go", stage.Args...)
+			cmd.Dir = projectPath
+			cmd.Env = append(os.Environ(), "GOWORK=off")
+			start := time.Now()
+			output, err := cmd.CombinedOutput()
+			result.DurationMS = time.Since(start).Milliseconds()
+			result.Status = checkPass
+			if err != nil {
+				result.Status = checkFail
+				result.Output = strings.TrimSpace(string(output))
+				if result.Output == "" {
+					result.Output = err.Error()
+				}
+				result.Hint = stage.Hint
+				report.OK = false
+			}
+		}
+		report.Stages = append(report.Stages, result)
+		printVerifyResult(w, result)
+	}
+	return report
+}
+
+// printVerifyResult prints a stage result, with its output and hint on failure
+func printVerifyResult(w io.Writer, r verifyResult) {
+	switch r.Status {
+	case checkPass:
+		fmt.Fprintln(w, Green(fmt.Sprintf("✅ %s (%.1fs)", r.Stage, float64(r.DurationMS)/1000)))
+	case checkSkip:
+		fmt.Fprintf(w, "⏭️  %s (skipped)\n", r.Stage)
+	default:
+		fmt.Fprintln(w, Red(fmt.Sprintf("❌ %s (%.1fs)", r.Stage, float64(r.DurationMS)/1000)))
+		for line := range strings.Lines(r.Output) {
+			fmt.Fprintln(w, "   "+strings.TrimRight(line, "\n"))
+		}
+		fmt.Fprintln(w, "   → "+r.Hint)
+	}
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tky0065/go-starter-kit/pkg/generator"
+)
+
+func TestVerifyStages(t *testing.T) {
+	names := func(stages []verifyStage) string {
+		var s []string
+		for _, stage := range stages {
+			s = append(s, stage.Name)
+		}
+		return strings.Join(s, ", ")
+	}
+
+	if got, want := names(verifyStages(generator.TemplateFull)), "go mod tidy, go vet ./..., go build ./..., go test ./..."; got != want {
+		t.Errorf("verifyStages(full) = %s, want %s", got, want)
+	}
+	if got, want := names(verifyStages(generator.TemplateGraphQL)), "go mod tidy, go generate ./..., go vet ./..., go build ./..., go test ./..."; got != want {
+		t.Errorf("verifyStages(graphql) = %s, want %s", got, want)
+	}
+}
+
+// writeTestModule writes a module without dependencies, so that it verifies offline
+func writeTestModule(t *testing.T, main string) string {
+	t.Helper()
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod":       "module verifyapp\n\ngo 1.22\n",
+		"main.go":      main,
+		"main_test.go": "package main\n\nimport \"testing\"\n\nfunc TestNothing(t *testing.T) {}\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestVerifyProject(t *testing.T) {
+	t.Run("pass", func(t *testing.T) {
+		dir := writeTestModule(t, "package main\n\nfunc main() {}\n")
+		var out bytes.Buffer
+		report := verifyProject(&out, dir, verifyStages(generator.TemplateFull))
+		if !report.OK || report.failed() != nil || len(report.Stages) != 4 {
+			t.Errorf("verifyProject() = %+v, want every stage to pass\n%s", report, out.String())
+		}
+		if !strings.Contains(out.String(), "✅ go test ./...") {
+			t.Errorf("output should report the stages, got:\n%s", out.String())
+		}
+	})
+
+	t.Run("vet failure", func(t *testing.T) {
+		dir := writeTestModule(t, "package main\n\nimport \"fmt\"\n\nfunc main() {\n\tfmt.Printf(\"%d\\n\", \"x\")\n}\n")
+		var out bytes.Buffer
+		report := verifyProject(&out, dir, verifyStages(generator.TemplateFull))
+
+		failed := report.failed()
+		if report.OK || failed == nil || failed.Stage != "go vet ./..." {
+			t.Fatalf("verifyProject() = %+v, want go vet to fail", report)
+		}
+		if !strings.Contains(failed.Output, "Printf format %d has arg \"x\" of wrong type string") || failed.Hint == "" {
+			t.Errorf("failed stage should carry the vet output and a hint, got %+v", failed)
+		}
+		for _, r := range report.Stages[2:] {
+			if r.Status != checkSkip {
+				t.Errorf("%s after the failure should be skipped, got %s", r.Stage, r.Status)
+			}
+		}
+		if !strings.Contains(out.String(), "⏭️  go build ./... (skipped)") {
+			t.Errorf("output should list the skipped stages, got:\n%s", out.String())
+		}
+	})
+}
+
+func TestPreviewProjectVerify(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	opts := testProjectOptions("verify-app", generator.TemplateGraphQL)
+	opts.Verify = true
+	var out bytes.Buffer
+	if err := previewProject(&out, opts, DryRunTree); err != nil {
+		t.Fatalf("previewProject() error = %v", err)
+	}
+	if !strings.Contains(out.String(), "  verify: go generate ./...\n  verify: go vet ./...\n") {
+		t.Errorf("dry run should list the verification stages, got:\n%s", out.String())
+	}
+}
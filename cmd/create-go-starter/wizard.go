@@ -0,0 +1,240 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/tky0065/go-starter-kit/pkg/generator"
+	"github.com/tky0065/go-starter-kit/pkg/utils"
+)
+
+// errWizardCancelled is returned when the user declines the summary
+var errWizardCancelled = errors.New("project creation cancelled")
+
+// WizardInput provides the answers to the wizard questions. It is an
+// interface so that the wizard can be driven by scripted input in tests.
+type WizardInput interface {
+	// ReadLine returns the next answer without its line terminator.
+	// It returns io.EOF once the input is exhausted.
+	ReadLine() (string, error)
+}
+
+// readerInput reads wizard answers line by line from an io.Reader
+type readerInput struct {
+	scanner *bufio.Scanner
+}
+
+// newReaderInput returns a WizardInput reading from r (e.g. os.Stdin)
+func newReaderInput(r io.Reader) *readerInput {
+	return &readerInput{scanner: bufio.NewScanner(r)}
+}
+
+func (r *readerInput) ReadLine() (string, error) {
+	if !r.scanner.Scan() {
+		if err := r.scanner.Err(); err != nil {
+			return "", err
+		}
+		return "", io.EOF
+	}
+	return strings.TrimSpace(r.scanner.Text()), nil
+}
+
+// isInteractive reports whether both stdin and stdout are attached to a terminal
+func isInteractive() bool {
+	for _, f := range []*os.File{os.Stdin, os.Stdout} {
+		info, err := f.Stat()
+		if err != nil || info.Mode()&os.ModeCharDevice == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// wizard asks the questions on out and reads the answers from in
+type wizard struct {
+	in  WizardInput
+	out io.Writer
+}
+
+// runWizard asks for the project options one question at a time, starting
+// from defaults (e.g. values given as flags). Each answer is checked with the
+// validators used for command-line arguments and asked again when invalid.
+// A summary is shown before returning; errWizardCancelled is returned if the
+// user does not confirm it.
+func runWizard(in WizardInput, out io.Writer, defaults generator.ProjectOptions) (generator.ProjectOptions, error) {
+	w := &wizard{in: in, out: out}
+	opts := defaults.WithDefaults()
+
+	fmt.Fprintln(out, Green("Welcome to create-go-starter! Answer a few questions to generate your project."))
+	fmt.Fprintln(out, "Press Enter to accept the default value shown in brackets.")
+
+	var err error
+	if opts.ProjectName, err = w.ask("Project name", opts.ProjectName, utils.ValidateGoModuleName); err != nil {
+		return opts, err
+	}
+
+	moduleDefault := opts.ModulePath
+	if moduleDefault == "" {
+		moduleDefault = opts.ProjectName
+	}
+	modulePath, err := w.ask("Go module path (e.g. github.com/org/service)", moduleDefault, func(answer string) error {
+		if answer == opts.ProjectName {
+			return nil
+		}
+		return utils.ValidateModulePath(answer)
+	})
+	if err != nil {
+		return opts, err
+	}
+	opts.ModulePath = ""
+	if modulePath != opts.ProjectName {
+		opts.ModulePath = modulePath
+	}
+
+	if opts.Template, err = w.askTemplate(opts.Template); err != nil {
+		return opts, err
+	}
+
+	if tmpl, _ := generator.LookupTemplate(opts.Template); isFeatureTemplate(tmpl) {
+		if opts.Features, err = w.askFeatures(opts.Template, opts.Features); err != nil {
+			return opts, err
+		}
+	}
+
+	printWizardSummary(out, opts)
+	confirmed, err := w.confirm("Generate the project?")
+	if err != nil {
+		return opts, err
+	}
+	if !confirmed {
+		return opts, errWizardCancelled
+	}
+	return opts, nil
+}
+
+// ask prints a question and reads answers until validate accepts one.
+// An empty answer selects def when it is not empty.
+func (w *wizard) ask(question, def string, validate func(string) error) (string, error) {
+	for {
+		if def != "" {
+			fmt.Fprintf(w.out, "%s [%s]: ", Cyan(question), def)
+		} else {
+			fmt.Fprintf(w.out, "%s: ", Cyan(question))
+		}
+
+		answer, err := w.in.ReadLine()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return "", fmt.Errorf("wizard input ended before %s was answered", strings.ToLower(question))
+			}
+			return "", fmt.Errorf("failed to read wizard input: %w", err)
+		}
+		if answer == "" {
+			answer = def
+		}
+		if answer == "" {
+			fmt.Fprintln(w.out, Red("A value is required"))
+			continue
+		}
+		if err := validate(answer); err != nil {
+			fmt.Fprintln(w.out, Red(err.Error()))
+			continue
+		}
+		return answer, nil
+	}
+}
+
+// askTemplate lists the registered templates and reads a template name or number
+func (w *wizard) askTemplate(def string) (string, error) {
+	fmt.Fprintln(w.out, "\nTemplates:")
+	templates := generator.Templates()
+	for i, tmpl := range templates {
+		fmt.Fprintf(w.out, "  %d) %-9s %s\n", i+1, tmpl.Name(), tmpl.Description())
+	}
+
+	answer, err := w.ask("Template", def, func(answer string) error {
+		if _, ok := templateByNumber(templates, answer); ok {
+			return nil
+		}
+		return generator.ValidateTemplate(answer)
+	})
+	if err != nil {
+		return "", err
+	}
+	if name, ok := templateByNumber(templates, answer); ok {
+		return name, nil
+	}
+	return answer, nil
+}
+
+// isFeatureTemplate reports whether optional features can be layered on tmpl
+func isFeatureTemplate(tmpl generator.Template) bool {
+	_, ok := tmpl.(generator.FeatureTemplate)
+	return ok
+}
+
+// templateByNumber returns the name of the template at the 1-based position answer
+func templateByNumber(templates []generator.Template, answer string) (string, bool) {
+	n, err := strconv.Atoi(answer)
+	if err != nil || n < 1 || n > len(templates) {
+		return "", false
+	}
+	return templates[n-1].Name(), true
+}
+
+// askFeatures lists the optional features and reads a comma-separated list
+func (w *wizard) askFeatures(template string, def []string) ([]string, error) {
+	fmt.Fprintln(w.out, "\nOptional features:")
+	for _, f := range generator.Features() {
+		fmt.Fprintf(w.out, "  %-9s %s\n", f.Name, f.Description)
+	}
+
+	defAnswer := strings.Join(def, ",")
+	if defAnswer == "" {
+		defAnswer = "none"
+	}
+	answer, err := w.ask("Features (comma-separated)", defAnswer, func(answer string) error {
+		_, err := generator.ResolveFeatures(template, generator.ParseFeatureList(answer))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return generator.ParseFeatureList(answer), nil
+}
+
+// confirm asks a yes/no question; an empty answer means yes
+func (w *wizard) confirm(question string) (bool, error) {
+	answer, err := w.ask(question, "yes", func(answer string) error {
+		switch strings.ToLower(answer) {
+		case "y", "yes", "n", "no":
+			return nil
+		}
+		return fmt.Errorf("please answer yes or no")
+	})
+	if err != nil {
+		return false, err
+	}
+	return strings.HasPrefix(strings.ToLower(answer), "y"), nil
+}
+
+// printWizardSummary prints the options the project will be generated with
+func printWizardSummary(w io.Writer, opts generator.ProjectOptions) {
+	features := "none"
+	if len(opts.Features) > 0 {
+		features = strings.Join(opts.Features, ", ")
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, Green("📋 Summary:"))
+	fmt.Fprintf(w, "  Project name: %s\n", opts.ProjectName)
+	fmt.Fprintf(w, "  Module path:  %s\n", opts.Module())
+	fmt.Fprintf(w, "  Template:     %s\n", opts.Template)
+	fmt.Fprintf(w, "  Features:     %s\n", features)
+	fmt.Fprintf(w, "  Directory:    ./%s\n", opts.ProjectName)
+}
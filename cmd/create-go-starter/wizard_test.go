@@ -0,0 +1,138 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/tky0065/go-starter-kit/pkg/generator"
+)
+
+// scriptedInput is a WizardInput returning predefined answers
+type scriptedInput struct {
+	answers []string
+}
+
+func (s *scriptedInput) ReadLine() (string, error) {
+	if len(s.answers) == 0 {
+		return "", io.EOF
+	}
+	answer := s.answers[0]
+	s.answers = s.answers[1:]
+	return answer, nil
+}
+
+func TestRunWizard(t *testing.T) {
+	tests := []struct {
+		name         string
+		answers      []string
+		defaults     generator.ProjectOptions
+		wantName     string
+		wantModule   string
+		wantTemplate string
+		wantOutput   []string
+	}{
+		{
+			name:         "defaults",
+			answers:      []string{"billing", "", "", "", ""},
+			defaults:     generator.DefaultProjectOptions(),
+			wantName:     "billing",
+			wantTemplate: generator.TemplateFull,
+			wantOutput:   []string{generator.TemplateMinimalDesc, generator.TemplateFullDesc, generator.TemplateGraphQLDesc, "Module path:  billing"},
+		},
+		{
+			name:         "module and template by number",
+			answers:      []string{"billing", "github.com/our-org/billing", "1", "", "y"},
+			defaults:     generator.DefaultProjectOptions(),
+			wantName:     "billing",
+			wantModule:   "github.com/our-org/billing",
+			wantTemplate: generator.TemplateMinimal,
+			wantOutput:   []string{"Module path:  github.com/our-org/billing", "Template:     minimal"},
+		},
+		{
+			name:         "invalid answers are asked again",
+			answers:      []string{"", "bad name", "billing", "our-org/billing", "", "rest", "graphql", "maybe", "yes"},
+			defaults:     generator.DefaultProjectOptions(),
+			wantName:     "billing",
+			wantTemplate: generator.TemplateGraphQL,
+			wantOutput:   []string{"A value is required", "invalid", "missing dot in first path element", "invalid template 'rest'", "please answer yes or no"},
+		},
+		{
+			name:         "flags are used as defaults",
+			answers:      []string{"", "", "", "", ""},
+			defaults:     generator.ProjectOptions{ProjectName: "api", ModulePath: "github.com/our-org/api", Template: generator.TemplateMinimal},
+			wantName:     "api",
+			wantModule:   "github.com/our-org/api",
+			wantTemplate: generator.TemplateMinimal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			opts, err := runWizard(&scriptedInput{answers: tt.answers}, &out, tt.defaults)
+			if err != nil {
+				t.Fatalf("runWizard() error = %v\nOutput:\n%s", err, out.String())
+			}
+			if opts.ProjectName != tt.wantName || opts.ModulePath != tt.wantModule || opts.Template != tt.wantTemplate {
+				t.Errorf("runWizard() = %+v, want name %q, module %q, template %q", opts, tt.wantName, tt.wantModule, tt.wantTemplate)
+			}
+			if err := opts.Validate(); err != nil {
+				t.Errorf("wizard options should be valid: %v", err)
+			}
+			for _, want := range append(tt.wantOutput, "Summary") {
+				if !strings.Contains(out.String(), want) {
+					t.Errorf("wizard output should contain %q, got:\n%s", want, out.String())
+				}
+			}
+		})
+	}
+}
+
+func TestRunWizardCancelled(t *testing.T) {
+	var out bytes.Buffer
+	_, err := runWizard(&scriptedInput{answers: []string{"billing", "", "", "", "n"}}, &out, generator.DefaultProjectOptions())
+	if !errors.Is(err, errWizardCancelled) {
+		t.Errorf("runWizard() error = %v, want errWizardCancelled", err)
+	}
+}
+
+func TestRunWizardInputEnded(t *testing.T) {
+	var out bytes.Buffer
+	_, err := runWizard(&scriptedInput{answers: []string{"billing"}}, &out, generator.DefaultProjectOptions())
+	if err == nil || !strings.Contains(err.Error(), "wizard input ended") {
+		t.Errorf("runWizard() error = %v, want input ended error", err)
+	}
+}
+
+func TestRunWizardFeatures(t *testing.T) {
+	var out bytes.Buffer
+	opts, err := runWizard(&scriptedInput{answers: []string{"billing", "", "minimal", "metrics, kafka", "redis, metrics", ""}}, &out, generator.DefaultProjectOptions())
+	if err != nil {
+		t.Fatalf("runWizard() error = %v\nOutput:\n%s", err, out.String())
+	}
+	if !slices.Equal(opts.Features, []string{"redis", "metrics"}) {
+		t.Errorf("features = %v, want [redis metrics]", opts.Features)
+	}
+	for _, want := range []string{"unknown feature 'kafka'", "Prometheus metrics endpoint", "Features:     redis, metrics"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("wizard output should contain %q, got:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestReaderInput(t *testing.T) {
+	in := newReaderInput(strings.NewReader("billing\r\n  minimal  \n"))
+	for _, want := range []string{"billing", "minimal"} {
+		got, err := in.ReadLine()
+		if err != nil || got != want {
+			t.Errorf("ReadLine() = %q, %v, want %q", got, err, want)
+		}
+	}
+	if _, err := in.ReadLine(); !errors.Is(err, io.EOF) {
+		t.Errorf("ReadLine() error = %v, want io.EOF", err)
+	}
+}
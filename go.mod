@@ -1,3 +1,7 @@
 module github.com/tky0065/go-starter-kit
 
 go 1.25.5
+
+require golang.org/x/mod v0.30.0
+
+require gopkg.in/yaml.v3 v3.0.1
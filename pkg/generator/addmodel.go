@@ -0,0 +1,558 @@
+package generator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/mod/modfile"
+)
+
+// AddModel renders the model layer for the project in projectPath and
+// registers the model in the project's fx modules, routes and migrations.
+// Nothing is written unless every file could be rendered and updated.
+// It returns the created and updated paths, relative to projectPath.
+func AddModel(projectPath string, m *Model) (created, updated []string, err error) {
+	data, err := loadProjectData(projectPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	data.Model = m
+
+	fw, err := data.Framework()
+	if err != nil {
+		return nil, nil, err
+	}
+	paths, contents, err := renderLayers(fw.overlay([]string{"model"}), data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	edits, err := relationEdits(projectPath, m)
+	if err != nil {
+		return nil, nil, err
+	}
+	edits = append(edits, modelEdits(m, data)...)
+
+	var files []FileGenerator
+	for _, p := range paths {
+		target := m.projectPath(p)
+		fullPath := filepath.Join(projectPath, filepath.FromSlash(target))
+		if _, err := os.Stat(fullPath); err == nil {
+			return nil, nil, fmt.Errorf("%s already exists: model %s was already added", target, m.Name)
+		}
+		// renderLayers has formatted the Go files
+		files = append(files, FileGenerator{Path: fullPath, Content: contents[p]})
+		created = append(created, target)
+	}
+
+	for _, edit := range edits {
+		fullPath := filepath.Join(projectPath, filepath.FromSlash(edit.Path))
+		src, err := os.ReadFile(fullPath)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read %s: add-model expects the layout of the full template: %w", edit.Path, err)
+		}
+		content, err := edit.Edit(src)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to update %s: %w", edit.Path, err)
+		}
+		files = append(files, FileGenerator{Path: fullPath, Content: string(content)})
+		updated = append(updated, edit.Path)
+	}
+
+	for _, file := range files {
+		if err := os.MkdirAll(filepath.Dir(file.Path), defaultDirPerm); err != nil {
+			return nil, nil, fmt.Errorf("failed to create directory for %s: %w", file.Path, err)
+		}
+		if err := os.WriteFile(file.Path, []byte(file.Content), 0644); err != nil {
+			return nil, nil, fmt.Errorf("failed to write file %s: %w", file.Path, err)
+		}
+	}
+	return created, updated, nil
+}
+
+// loadProjectData returns the template context of the project in projectPath,
+// read from its manifest. Projects generated before the manifest existed fall
+// back to their project spec and go.mod, and are full Fiber projects when they
+// have neither.
+func loadProjectData(projectPath string) (TemplateData, error) {
+	manifest, err := LoadManifest(projectPath)
+	if err != nil && !errors.Is(err, ErrNoManifest) {
+		return TemplateData{}, err
+	}
+	if manifest != nil {
+		if err := manifest.Options.Validate(); err != nil {
+			return TemplateData{}, fmt.Errorf("invalid options in %s: %w", ManifestPath, err)
+		}
+		data := manifest.Options.TemplateData()
+		data.ModulePath = manifest.ModulePath
+		return checkModelProject(manifest.Template, data)
+	}
+
+	goMod, err := os.ReadFile(filepath.Join(projectPath, "go.mod"))
+	if err != nil {
+		return TemplateData{}, fmt.Errorf("no go.mod found in %s: run add-model inside a project generated by create-go-starter", projectPath)
+	}
+	modulePath := modfile.ModulePath(goMod)
+	if modulePath == "" {
+		return TemplateData{}, fmt.Errorf("go.mod in %s has no module directive", projectPath)
+	}
+
+	opts := DefaultProjectOptions()
+	opts.ProjectName = filepath.Base(modulePath)
+	specPath := filepath.Join(projectPath, filepath.FromSlash(projectSpecPath))
+	if _, err := os.Stat(specPath); err == nil {
+		if opts, err = LoadProjectConfig(specPath); err != nil {
+			return TemplateData{}, err
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return TemplateData{}, err
+	}
+	if err := opts.Validate(); err != nil {
+		return TemplateData{}, fmt.Errorf("invalid project spec %s: %w", projectSpecPath, err)
+	}
+
+	data := opts.TemplateData()
+	data.ModulePath = modulePath
+	return checkModelProject(opts.Template, data)
+}
+
+// checkModelProject checks that add-model can wire a model into a project
+// generated from template with data
+func checkModelProject(template string, data TemplateData) (TemplateData, error) {
+	if !data.HasFeature("users") {
+		return TemplateData{}, fmt.Errorf("add-model needs a project with the users feature (full template, or --features users): template '%s' does not have it", template)
+	}
+	return data, nil
+}
+
+// projectPath returns the path in the project of a file of the model layer:
+// "model" stands for the package in the domain directory and for the file
+// name elsewhere
+func (m *Model) projectPath(p string) string {
+	dir, file := path.Split(p)
+	dir = strings.Replace(dir, "domain/model/", "domain/"+m.Package+"/", 1)
+	return dir + strings.Replace(file, "model", m.File, 1)
+}
+
+// sourceEdit is a change made by add-model to an existing Go file of the project
+type sourceEdit struct {
+	// Path is the edited file, relative to the project root
+	Path string
+	// Edit returns the updated content of the file
+	Edit func(src []byte) ([]byte, error)
+}
+
+// modelEdits lists the registration points of a model: its repository and
+// handler providers, its domain module, its routes and its migration
+func modelEdits(m *Model, data TemplateData) []sourceEdit {
+	domainImport := data.ModulePath + "/internal/domain/" + m.Package
+	return []sourceEdit{
+		{
+			Path: "internal/adapters/repository/module.go",
+			Edit: func(src []byte) ([]byte, error) {
+				return appendModuleArg(src, fmt.Sprintf("fx.Provide(func(db *gorm.DB) interfaces.%[1]sRepository {\n\treturn New%[1]sRepository(db)\n})", m.Name))
+			},
+		},
+		{
+			Path: "internal/adapters/handlers/module.go",
+			Edit: func(src []byte) ([]byte, error) {
+				src, err := addImport(src, domainImport)
+				if err != nil {
+					return nil, err
+				}
+				return appendModuleArg(src, fmt.Sprintf("fx.Provide(func(s *%[1]s.Service) *%[2]sHandler {\n\treturn New%[2]sHandler(s)\n})", m.Package, m.Name))
+			},
+		},
+		{
+			Path: "internal/infrastructure/server/server.go",
+			Edit: func(src []byte) ([]byte, error) {
+				routes, err := importName(src, data.ModulePath+"/internal/adapters/http")
+				if err != nil {
+					return nil, err
+				}
+				return appendModuleArg(src, fmt.Sprintf("fx.Invoke(%s.Register%sRoutes)", routes, m.Name))
+			},
+		},
+		{
+			Path: "internal/infrastructure/database/database.go",
+			Edit: func(src []byte) ([]byte, error) {
+				return editSource(src, func(fset *token.FileSet, file *ast.File) ([]byte, error) {
+					call := findCall(file, func(call *ast.CallExpr) bool {
+						sel, ok := call.Fun.(*ast.SelectorExpr)
+						return ok && sel.Sel.Name == "AutoMigrate"
+					})
+					if call == nil {
+						return nil, fmt.Errorf("no AutoMigrate call found")
+					}
+					// GORM creates the foreign keys and join tables of a model
+					// with its table: the related tables must be migrated first
+					for _, target := range m.RelatedModels() {
+						if !slices.ContainsFunc(call.Args, func(arg ast.Expr) bool { return isModelLiteral(arg, target) }) {
+							return nil, fmt.Errorf("models.%s is not migrated by AutoMigrate: add it before %s", target, m.Name)
+						}
+					}
+					return appendArg(fset, src, call, fmt.Sprintf("&models.%s{}", m.Name)), nil
+				})
+			},
+		},
+		{
+			Path: "cmd/main.go",
+			Edit: func(src []byte) ([]byte, error) {
+				src, err := addImport(src, domainImport)
+				if err != nil {
+					return nil, err
+				}
+				return editSource(src, func(fset *token.FileSet, file *ast.File) ([]byte, error) {
+					call := findCall(file, func(call *ast.CallExpr) bool {
+						sel, ok := call.Fun.(*ast.SelectorExpr)
+						return ok && sel.Sel.Name == "New" && isIdent(sel.X, "fx")
+					})
+					if call == nil || len(call.Args) == 0 {
+						return nil, fmt.Errorf("no fx.New call found")
+					}
+					// Next to the other domain services, or before the server module
+					// otherwise, which must stay last
+					after := slices.IndexFunc(call.Args, func(arg ast.Expr) bool {
+						sel, ok := arg.(*ast.SelectorExpr)
+						return ok && isIdent(sel.X, "user") && sel.Sel.Name == "Module"
+					})
+					arg := m.Package + ".Module"
+					if after < 0 {
+						return insertArgBefore(fset, file, src, call.Args[len(call.Args)-1], arg), nil
+					}
+					return insertArgAfter(fset, src, call.Args[after], arg), nil
+				})
+			},
+		},
+	}
+}
+
+// relationEdits checks that the models the relations of m refer to exist in
+// the project, with a response DTO to nest, and returns the edits adding the
+// foreign key of each has_many relation to its target when missing
+func relationEdits(projectPath string, m *Model) ([]sourceEdit, error) {
+	if len(m.Relations) == 0 {
+		return nil, nil
+	}
+	models, err := packageDecls(projectPath, "internal/models")
+	if err != nil {
+		return nil, err
+	}
+	handlers, err := packageDecls(projectPath, "internal/adapters/handlers")
+	if err != nil {
+		return nil, err
+	}
+
+	var edits []sourceEdit
+	for _, r := range m.Relations {
+		modelFile, ok := models[r.Target]
+		if !ok {
+			return nil, fmt.Errorf("relation '%s': model %s not found in internal/models, add it first with add-model", r.JSON, r.Target)
+		}
+		response := "new" + r.Target + "Response"
+		if r.Target == "User" {
+			response = "ProfileResponse"
+		}
+		if _, ok := handlers[response]; !ok {
+			return nil, fmt.Errorf("relation '%s': %s not found in internal/adapters/handlers to render %s", r.JSON, response, r.Target)
+		}
+		if r.Kind != RelationHasMany || slices.ContainsFunc(edits, func(e sourceEdit) bool { return e.Path == modelFile }) {
+			continue
+		}
+
+		edits = append(edits, sourceEdit{
+			Path: modelFile,
+			Edit: func(src []byte) ([]byte, error) {
+				return addForeignKey(src, r)
+			},
+		})
+	}
+	return edits, nil
+}
+
+// addForeignKey adds the nullable foreign key of the has_many relation r to
+// its target model, before its timestamps, unless the field exists
+func addForeignKey(src []byte, r ModelRelation) ([]byte, error) {
+	return editSource(src, func(fset *token.FileSet, file *ast.File) ([]byte, error) {
+		var fields *ast.FieldList
+		ast.Inspect(file, func(n ast.Node) bool {
+			if spec, ok := n.(*ast.TypeSpec); ok && spec.Name.Name == r.Target {
+				if st, ok := spec.Type.(*ast.StructType); ok {
+					fields = st.Fields
+				}
+			}
+			return fields == nil
+		})
+		if fields == nil {
+			return nil, fmt.Errorf("struct %s not found", r.Target)
+		}
+
+		pos := fields.Closing
+		for _, field := range fields.List {
+			for _, name := range field.Names {
+				if name.Name == r.ForeignKey {
+					return src, nil
+				}
+				if name.Name == "CreatedAt" && pos == fields.Closing {
+					pos = field.Pos()
+				}
+			}
+		}
+		line := fmt.Sprintf("%s *uint `gorm:\"index\" json:\"%s,omitempty\"`", r.ForeignKey, r.ForeignKeyJSON)
+		return splice(src, lineStart(src, fset.Position(pos).Offset), indentLines(line, "\t")), nil
+	})
+}
+
+// packageDecls returns the top-level types and functions declared by the Go
+// files of the project directory dir, with the path of their file
+func packageDecls(projectPath, dir string) (map[string]string, error) {
+	paths, err := filepath.Glob(filepath.Join(projectPath, filepath.FromSlash(dir), "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	decls := make(map[string]string)
+	for _, p := range paths {
+		if strings.HasSuffix(p, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(token.NewFileSet(), p, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		rel := path.Join(dir, filepath.Base(p))
+		for _, decl := range file.Decls {
+			switch d := decl.(type) {
+			case *ast.FuncDecl:
+				if d.Recv == nil {
+					decls[d.Name.Name] = rel
+				}
+			case *ast.GenDecl:
+				for _, spec := range d.Specs {
+					if ts, ok := spec.(*ast.TypeSpec); ok {
+						decls[ts.Name.Name] = rel
+					}
+				}
+			}
+		}
+	}
+	return decls, nil
+}
+
+// isModelLiteral reports whether expr is &models.<name>{}
+func isModelLiteral(expr ast.Expr, name string) bool {
+	unary, ok := expr.(*ast.UnaryExpr)
+	if !ok || unary.Op != token.AND {
+		return false
+	}
+	lit, ok := unary.X.(*ast.CompositeLit)
+	if !ok {
+		return false
+	}
+	sel, ok := lit.Type.(*ast.SelectorExpr)
+	return ok && isIdent(sel.X, "models") && sel.Sel.Name == name
+}
+
+// editSource parses src and returns the result of edit, checking that it
+// still parses. Files that were gofmt-formatted are formatted again, so that
+// inserted struct fields stay aligned; the others are left as they are.
+func editSource(src []byte, edit func(fset *token.FileSet, file *ast.File) ([]byte, error)) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	result, err := edit(fset, file)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := parser.ParseFile(token.NewFileSet(), "", result, parser.ParseComments); err != nil {
+		return nil, fmt.Errorf("edited source does not parse: %w", err)
+	}
+	if formatted, err := format.Source(src); err == nil && bytes.Equal(formatted, src) {
+		return format.Source(result)
+	}
+	return result, nil
+}
+
+// appendModuleArg adds arg as the last argument of the package's
+// var Module = fx.Module(...) declaration
+func appendModuleArg(src []byte, arg string) ([]byte, error) {
+	return editSource(src, func(fset *token.FileSet, file *ast.File) ([]byte, error) {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.VAR {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				vs := spec.(*ast.ValueSpec)
+				if len(vs.Names) != 1 || vs.Names[0].Name != "Module" || len(vs.Values) != 1 {
+					continue
+				}
+				if call, ok := vs.Values[0].(*ast.CallExpr); ok && len(call.Args) > 0 {
+					return appendArg(fset, src, call, arg), nil
+				}
+			}
+		}
+		return nil, fmt.Errorf("no fx module declaration (var Module = fx.Module(...)) found")
+	})
+}
+
+// findCall returns the first call of file matching match
+func findCall(file *ast.File, match func(call *ast.CallExpr) bool) *ast.CallExpr {
+	var found *ast.CallExpr
+	ast.Inspect(file, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok && found == nil && match(call) {
+			found = call
+		}
+		return found == nil
+	})
+	return found
+}
+
+// isIdent reports whether expr is the identifier name
+func isIdent(expr ast.Expr, name string) bool {
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Name == name
+}
+
+// appendArg adds arg as the last argument of call: on its own line, indented
+// like the previous argument, when the call spans several lines
+func appendArg(fset *token.FileSet, src []byte, call *ast.CallExpr, arg string) []byte {
+	last := call.Args[len(call.Args)-1]
+	if fset.Position(last.End()).Line == fset.Position(call.Rparen).Line {
+		return splice(src, fset.Position(last.End()).Offset, ", "+arg)
+	}
+	indent := lineIndent(src, fset.Position(last.Pos()).Offset)
+	return splice(src, lineStart(src, fset.Position(call.Rparen).Offset), indentLines(arg+",", indent))
+}
+
+// insertArgAfter adds arg on the line following the argument after
+func insertArgAfter(fset *token.FileSet, src []byte, after ast.Expr, arg string) []byte {
+	end := fset.Position(after.End()).Offset
+	next := bytes.IndexByte(src[end:], '\n') + end + 1
+	return splice(src, next, indentLines(arg+",", lineIndent(src, fset.Position(after.Pos()).Offset)))
+}
+
+// insertArgBefore adds arg on the line preceding the argument before, above
+// the comments attached to it
+func insertArgBefore(fset *token.FileSet, file *ast.File, src []byte, before ast.Expr, arg string) []byte {
+	pos := before.Pos()
+	for _, group := range file.Comments {
+		if fset.Position(group.End()).Line == fset.Position(pos).Line-1 {
+			pos = group.Pos()
+		}
+	}
+	offset := fset.Position(pos).Offset
+	return splice(src, lineStart(src, offset), indentLines(arg+",", lineIndent(src, offset)))
+}
+
+// importName returns the name the file src refers to the package path by
+func importName(src []byte, importPath string) (string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "", src, parser.ImportsOnly)
+	if err != nil {
+		return "", err
+	}
+	for _, spec := range file.Imports {
+		if p, _ := strconv.Unquote(spec.Path.Value); p == importPath {
+			if spec.Name != nil {
+				return spec.Name.Name, nil
+			}
+			return path.Base(importPath), nil
+		}
+	}
+	return "", fmt.Errorf("package %s is not imported", importPath)
+}
+
+// addImport adds importPath to the imports of src, next to the imports
+// sharing its first path element so that the group stays sorted
+func addImport(src []byte, importPath string) ([]byte, error) {
+	return editSource(src, func(fset *token.FileSet, file *ast.File) ([]byte, error) {
+		var decl *ast.GenDecl
+		for _, d := range file.Decls {
+			if gen, ok := d.(*ast.GenDecl); ok && gen.Tok == token.IMPORT {
+				decl = gen
+				break
+			}
+		}
+		line := strconv.Quote(importPath)
+		if decl == nil {
+			return nil, fmt.Errorf("no import declaration found")
+		}
+		if !decl.Lparen.IsValid() {
+			// A single import: turn it into a block
+			spec := decl.Specs[0]
+			start, end := fset.Position(spec.Pos()).Offset, fset.Position(spec.End()).Offset
+			block := "(\n\t" + string(src[start:end]) + "\n\t" + line + "\n)"
+			return slices.Concat(src[:start], []byte(block), src[end:]), nil
+		}
+
+		root := strings.SplitN(importPath, "/", 2)[0]
+		var after, before ast.Spec
+		for _, s := range decl.Specs {
+			spec := s.(*ast.ImportSpec)
+			p, _ := strconv.Unquote(spec.Path.Value)
+			if p == importPath {
+				return src, nil
+			}
+			if strings.SplitN(p, "/", 2)[0] != root {
+				continue
+			}
+			if p < importPath {
+				after = spec
+			} else if before == nil {
+				before = spec
+			}
+		}
+		switch {
+		case after != nil:
+			end := fset.Position(after.End()).Offset
+			next := bytes.IndexByte(src[end:], '\n') + end + 1
+			return splice(src, next, "\t"+line+"\n"), nil
+		case before != nil:
+			return splice(src, lineStart(src, fset.Position(before.Pos()).Offset), "\t"+line+"\n"), nil
+		default:
+			return splice(src, lineStart(src, fset.Position(decl.Rparen).Offset), "\t"+line+"\n"), nil
+		}
+	})
+}
+
+// splice returns src with text inserted at offset
+func splice(src []byte, offset int, text string) []byte {
+	return slices.Concat(src[:offset], []byte(text), src[offset:])
+}
+
+// lineStart returns the offset of the beginning of the line containing offset
+func lineStart(src []byte, offset int) int {
+	return bytes.LastIndexByte(src[:offset], '\n') + 1
+}
+
+// lineIndent returns the leading whitespace of the line containing offset
+func lineIndent(src []byte, offset int) string {
+	start := lineStart(src, offset)
+	end := start
+	for end < len(src) && (src[end] == '\t' || src[end] == ' ') {
+		end++
+	}
+	return string(src[start:end])
+}
+
+// indentLines prefixes every line of text with indent and ends it with a newline
+func indentLines(text, indent string) string {
+	lines := strings.Split(text, "\n")
+	for i, l := range lines {
+		lines[i] = indent + l
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
@@ -0,0 +1,238 @@
+package generator
+
+import (
+	"context"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeTestProject creates a project generated with opts in a temporary
+// directory, with Create, and returns its path
+func writeTestProject(t *testing.T, opts ProjectOptions) string {
+	t.Helper()
+	projectPath := filepath.Join(t.TempDir(), opts.ProjectName)
+	if err := Create(context.Background(), opts, projectPath, nil); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	return projectPath
+}
+
+// TestAddModel tests that add-model generates the resource for each router
+// and registers it in the fx modules, the routes and the migrations
+func TestAddModel(t *testing.T) {
+	for _, framework := range ValidFrameworks {
+		t.Run(framework, func(t *testing.T) {
+			opts := testProjectOptions("blog", TemplateFull)
+			opts.Framework = framework
+			projectPath := writeTestProject(t, opts)
+
+			m, err := ParseModel("BlogPost", "title:string,published_at:time")
+			if err != nil {
+				t.Fatal(err)
+			}
+			created, updated, err := AddModel(projectPath, m)
+			if err != nil {
+				t.Fatalf("AddModel() error = %v", err)
+			}
+			if len(created) != 8 || len(updated) != 5 {
+				t.Errorf("created %v, updated %v", created, updated)
+			}
+
+			want := map[string][]string{
+				"internal/models/blog_post.go":                         {"type BlogPost struct", "`gorm:\"not null\" json:\"published_at\"`"},
+				"internal/interfaces/blog_post_repository.go":          {"type BlogPostRepository interface"},
+				"internal/adapters/repository/blog_post_repository.go": {"func NewBlogPostRepository(db *gorm.DB) *BlogPostRepository"},
+				"internal/domain/blogpost/service.go":                  {"package blogpost", `"BLOG_POST_NOT_FOUND"`},
+				"internal/domain/blogpost/module.go":                   {`fx.Module("blogpost"`},
+				"internal/domain/blogpost/service_test.go":             {"func TestServiceUpdate("},
+				"internal/adapters/handlers/blog_post_handler.go":      {"func NewBlogPostHandler(service *blogpost.Service) *BlogPostHandler", "@Router /blog-posts/{id} [put]"},
+				"internal/adapters/http/blog_post_routes.go":           {"func RegisterBlogPostRoutes(", "/api/v1/blog-posts"},
+				"internal/adapters/repository/module.go":               {"\tfx.Provide(func(db *gorm.DB) interfaces.BlogPostRepository {\n\t\treturn NewBlogPostRepository(db)\n\t}),\n)"},
+				"internal/adapters/handlers/module.go":                 {"\t\"blog/internal/domain/blogpost\"\n\t\"blog/internal/domain/user\"\n", "\tfx.Provide(func(s *blogpost.Service) *BlogPostHandler {\n"},
+				"internal/infrastructure/server/server.go":             {"\tfx.Invoke(httpRoutes.RegisterBlogPostRoutes),\n)"},
+				"internal/infrastructure/database/database.go":         {"db.AutoMigrate(&models.User{}, &models.RefreshToken{}, &models.BlogPost{})"},
+				"cmd/main.go": {"\t\"blog/internal/domain/blogpost\"\n", "\t\tuser.Module,\n\t\tblogpost.Module,\n"},
+			}
+			for path, wants := range want {
+				content, err := os.ReadFile(filepath.Join(projectPath, path))
+				if err != nil {
+					t.Errorf("%s: %v", path, err)
+					continue
+				}
+				for _, s := range wants {
+					if !strings.Contains(string(content), s) {
+						t.Errorf("%s should contain %q, got:\n%s", path, s, content)
+					}
+				}
+				if _, err := parser.ParseFile(token.NewFileSet(), path, content, parser.AllErrors); err != nil {
+					t.Errorf("%s does not parse: %v", path, err)
+				}
+			}
+
+			// Adding the same model again fails without touching the project
+			before, _ := os.ReadFile(filepath.Join(projectPath, "cmd/main.go"))
+			if _, _, err := AddModel(projectPath, m); err == nil || !strings.Contains(err.Error(), "already exists") {
+				t.Errorf("second AddModel() error = %v, want already exists", err)
+			}
+			after, _ := os.ReadFile(filepath.Join(projectPath, "cmd/main.go"))
+			if string(before) != string(after) {
+				t.Errorf("failed AddModel() should not modify cmd/main.go")
+			}
+		})
+	}
+}
+
+// TestAddModelRelations tests that relations check their targets, preload
+// them and are migrated after them
+func TestAddModelRelations(t *testing.T) {
+	projectPath := writeTestProject(t, testProjectOptions("blog", TemplateFull))
+	add := func(name, fields string) error {
+		m, err := ParseModel(name, fields)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, _, err = AddModel(projectPath, m)
+		return err
+	}
+
+	if err := add("Post", "title:string,tags:many_to_many:Tag"); err == nil || !strings.Contains(err.Error(), "model Tag not found") {
+		t.Fatalf("AddModel() with a missing target error = %v", err)
+	}
+	for _, m := range []struct{ name, fields string }{
+		{"Tag", "name:string"},
+		{"Comment", "body:text"},
+		{"Post", "title:string,author:belongs_to:User,comments:has_many:Comment,tags:many_to_many:Tag"},
+	} {
+		if err := add(m.name, m.fields); err != nil {
+			t.Fatalf("AddModel(%s) error = %v", m.name, err)
+		}
+	}
+
+	want := map[string][]string{
+		"internal/models/post.go":    {"Author    *User", "Tags      []Tag          `gorm:\"many2many:post_tags\" json:\"tags,omitempty\"`"},
+		"internal/models/comment.go": {"\tPostID    *uint          `gorm:\"index\" json:\"post_id,omitempty\"`\n\tCreatedAt"},
+		"internal/adapters/repository/post_repository.go": {
+			`return db.Preload("Author").Preload("Comments").Preload("Tags")`,
+			`tx.Model(item).Omit("Tags.*").Association("Tags").Replace(item.Tags)`,
+			`"INVALID_AUTHOR_ID"`,
+		},
+		"internal/adapters/handlers/post_handler.go":   {"[]uint `json:\"tag_ids\" validate:\"unique\"`", "*ProfileResponse", "`json:\"author,omitempty\"`", "resp.Tags[i] = newTagResponse(&item.Tags[i])"},
+		"internal/domain/post/service.go":              {"item.Tags = changes.Tags"},
+		"internal/infrastructure/database/database.go": {"&models.Tag{}, &models.Comment{}, &models.Post{})"},
+	}
+	for path, wants := range want {
+		content, err := os.ReadFile(filepath.Join(projectPath, path))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, s := range wants {
+			if !strings.Contains(string(content), s) {
+				t.Errorf("%s should contain %q, got:\n%s", path, s, content)
+			}
+		}
+	}
+
+	// Related tables must be migrated first
+	databasePath := filepath.Join(projectPath, "internal/infrastructure/database/database.go")
+	database, _ := os.ReadFile(databasePath)
+	if err := os.WriteFile(databasePath, []byte(strings.Replace(string(database), "&models.Tag{}, ", "", 1)), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := add("Bookmark", "tag:belongs_to:Tag"); err == nil || !strings.Contains(err.Error(), "models.Tag is not migrated") {
+		t.Errorf("AddModel() with an unmigrated target error = %v", err)
+	}
+}
+
+// TestAddModelRequiresUsers tests that add-model refuses projects without the users layer
+func TestAddModelRequiresUsers(t *testing.T) {
+	projectPath := writeTestProject(t, testProjectOptions("plain", TemplateMinimal))
+	m, err := ParseModel("Post", "title:string")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := AddModel(projectPath, m); err == nil || !strings.Contains(err.Error(), "users feature") {
+		t.Errorf("AddModel() error = %v, want users feature error", err)
+	}
+	if _, err := os.Stat(filepath.Join(projectPath, "internal/models/post.go")); err == nil {
+		t.Errorf("AddModel() should not write files on error")
+	}
+}
+
+// TestLoadProjectData tests that add-model reads the project layout from the
+// manifest, and from go.mod and the project spec without one
+func TestLoadProjectData(t *testing.T) {
+	opts := testProjectOptions("shop", TemplateMinimal)
+	opts.ModulePath = "github.com/acme/shop-api"
+	opts.Features = []string{"users"}
+	opts.Framework = "chi"
+	projectPath := writeTestProject(t, opts)
+
+	// The manifest alone tells the framework and features
+	if err := os.Remove(filepath.Join(projectPath, filepath.FromSlash(projectSpecPath))); err != nil {
+		t.Fatal(err)
+	}
+	data, err := loadProjectData(projectPath)
+	if err != nil {
+		t.Fatalf("loadProjectData() error = %v", err)
+	}
+	if data.ModulePath != opts.ModulePath || data.HTTPFramework != "chi" || !data.HasFeature("users") {
+		t.Errorf("data = %+v, want the layout recorded in the manifest", data)
+	}
+
+	// Without manifest nor spec, a full Fiber project with the module of go.mod
+	if err := os.Remove(filepath.Join(projectPath, ManifestPath)); err != nil {
+		t.Fatal(err)
+	}
+	if data, err = loadProjectData(projectPath); err != nil {
+		t.Fatalf("loadProjectData() error = %v", err)
+	}
+	if data.ModulePath != opts.ModulePath || data.HTTPFramework != DefaultFramework {
+		t.Errorf("data = %+v, want a full Fiber project", data)
+	}
+}
+
+// TestAddModelEdits tests the source edits on hand-written variations of the wiring files
+func TestAddModelEdits(t *testing.T) {
+	t.Run("single line call", func(t *testing.T) {
+		src := "package database\n\nfunc migrate() error {\n\treturn db.AutoMigrate(&models.User{})\n}\n"
+		got, err := editSource([]byte(src), func(fset *token.FileSet, file *ast.File) ([]byte, error) {
+			return appendArg(fset, []byte(src), findCall(file, func(*ast.CallExpr) bool { return true }), "&models.Post{}"), nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(got), "db.AutoMigrate(&models.User{}, &models.Post{})") {
+			t.Errorf("got:\n%s", got)
+		}
+	})
+
+	t.Run("single import", func(t *testing.T) {
+		src := "package handlers\n\nimport \"go.uber.org/fx\"\n\nvar Module = fx.Module(\"handlers\")\n"
+		got, err := addImport([]byte(src), "app/internal/domain/post")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(got), "import (\n\t\"app/internal/domain/post\"\n\t\"go.uber.org/fx\"\n)") {
+			t.Errorf("got:\n%s", got)
+		}
+	})
+
+	t.Run("import already present", func(t *testing.T) {
+		src := "package main\n\nimport (\n\t\"app/internal/domain/post\"\n)\n"
+		got, err := addImport([]byte(src), "app/internal/domain/post")
+		if err != nil || string(got) != src {
+			t.Errorf("addImport() = %s, %v; want unchanged", got, err)
+		}
+	})
+
+	t.Run("missing module", func(t *testing.T) {
+		if _, err := appendModuleArg([]byte("package repository\n"), "fx.Provide(nil)"); err == nil {
+			t.Error("appendModuleArg() should fail without a Module declaration")
+		}
+	})
+}
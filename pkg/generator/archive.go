@@ -0,0 +1,86 @@
+package generator
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Archive formats accepted by NewArchiveSink
+const (
+	ArchiveTarGz = "tar.gz"
+	ArchiveZip   = "zip"
+)
+
+// ValidArchiveFormats lists the archive formats a project can be written as
+var ValidArchiveFormats = []string{ArchiveTarGz, ArchiveZip}
+
+// ArchiveSink is a Sink writing an archive. Close must be called to complete
+// the archive.
+type ArchiveSink interface {
+	Sink
+	Close() error
+}
+
+// ArchiveFormatOf returns the archive format matching the extension of name:
+// .tar.gz or .tgz for ArchiveTarGz, .zip for ArchiveZip
+func ArchiveFormatOf(name string) (string, error) {
+	lower := strings.ToLower(name)
+	switch {
+	case strings.HasSuffix(lower, ".tar.gz"), strings.HasSuffix(lower, ".tgz"):
+		return ArchiveTarGz, nil
+	case strings.HasSuffix(lower, ".zip"):
+		return ArchiveZip, nil
+	}
+	return "", fmt.Errorf("cannot tell the archive format of '%s': use a .tar.gz, .tgz or .zip extension", name)
+}
+
+// NewArchiveSink returns a TarSink or a ZipSink, depending on format, writing
+// to w with every entry under the prefix directory
+func NewArchiveSink(w io.Writer, format, prefix string) (ArchiveSink, error) {
+	switch format {
+	case ArchiveTarGz:
+		return NewTarSink(w, prefix), nil
+	case ArchiveZip:
+		return NewZipSink(w, prefix), nil
+	}
+	return nil, fmt.Errorf("invalid archive format '%s': valid options are: %s", format, strings.Join(ValidArchiveFormats, ", "))
+}
+
+// WriteDir writes the directories and regular files found under dir to sink,
+// keeping their permissions. It lets a project generated on disk, for
+// instance with its git repository, be written to an archive.
+func WriteDir(ctx context.Context, sink Sink, dir string) error {
+	return filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil || rel == "." {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if d.IsDir() {
+			return withKind(ErrWrite, sink.Mkdir(rel))
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		content, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		return withKind(ErrWrite, sink.WriteFile(rel, content, info.Mode().Perm()))
+	})
+}
@@ -0,0 +1,59 @@
+package generator
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestArchiveFormatOf(t *testing.T) {
+	tests := []struct {
+		name, want string
+		wantErr    bool
+	}{
+		{"app.tar.gz", ArchiveTarGz, false},
+		{"out/app.TGZ", ArchiveTarGz, false},
+		{"app.zip", ArchiveZip, false},
+		{"app.tar", "", true},
+		{"app", "", true},
+	}
+	for _, tt := range tests {
+		got, err := ArchiveFormatOf(tt.name)
+		if got != tt.want || (err != nil) != tt.wantErr {
+			t.Errorf("ArchiveFormatOf(%q) = %q, %v, want %q (error: %v)", tt.name, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestNewArchiveSinkInvalidFormat(t *testing.T) {
+	if _, err := NewArchiveSink(nil, "rar", "app"); err == nil {
+		t.Error("NewArchiveSink() should reject an unknown format")
+	}
+}
+
+// TestWriteDirMatchesGenerate tests that a project created on disk and copied
+// with WriteDir holds the same files, with the same modes, as one generated
+// straight into a sink
+func TestWriteDirMatchesGenerate(t *testing.T) {
+	opts := testProjectOptions("copied-app", TemplateMinimal)
+	want := NewMemorySink()
+	if err := Generate(context.Background(), opts, want, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	dir := filepath.Join(t.TempDir(), "copied-app")
+	if err := Create(context.Background(), opts, dir, nil); err != nil {
+		t.Fatal(err)
+	}
+	// Files are copied with their permissions, whatever the umask
+	if err := os.Chmod(filepath.Join(dir, "setup.sh"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	got := NewMemorySink()
+	if err := WriteDir(context.Background(), got, dir); err != nil {
+		t.Fatalf("WriteDir() error = %v", err)
+	}
+	compareSinks(t, got, want)
+}
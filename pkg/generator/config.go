@@ -0,0 +1,64 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// projectSpecPath is where the resolved project spec is written in the
+// generated project, relative to its root
+const projectSpecPath = ".go-starter/project.yaml"
+
+// LoadProjectConfig reads a YAML or JSON project spec. Fields missing from the
+// file keep their default values, and an empty or comment-only file is an
+// empty spec. Unknown fields are rejected so that typos do not go unnoticed.
+func LoadProjectConfig(path string) (ProjectOptions, error) {
+	opts := DefaultProjectOptions()
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return opts, fmt.Errorf("failed to read config file %s: %w", path, err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		decoder := json.NewDecoder(bytes.NewReader(content))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(&opts)
+	default:
+		decoder := yaml.NewDecoder(bytes.NewReader(content))
+		decoder.KnownFields(true)
+		err = decoder.Decode(&opts)
+	}
+	if err != nil && !errors.Is(err, io.EOF) {
+		return opts, fmt.Errorf("invalid config file %s: %w", path, err)
+	}
+
+	return opts, nil
+}
+
+// projectSpecFile returns the resolved project spec as a file to write in the
+// generated project, so it can be regenerated (--config) or audited later.
+func projectSpecFile(projectPath string, opts ProjectOptions) (FileGenerator, error) {
+	content, err := yaml.Marshal(opts.WithDefaults())
+	if err != nil {
+		return FileGenerator{}, fmt.Errorf("failed to encode project spec: %w", err)
+	}
+
+	header := "# Project spec generated by create-go-starter.\n" +
+		"# Regenerate the project from its parent directory with:\n" +
+		"#   create-go-starter --config " + opts.ProjectName + "/" + projectSpecPath + "\n"
+
+	return FileGenerator{
+		Path:    filepath.Join(projectPath, filepath.FromSlash(projectSpecPath)),
+		Content: header + string(content),
+	}, nil
+}
@@ -0,0 +1,141 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadProjectConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		want    ProjectOptions
+		wantErr string
+	}{
+		{
+			name: "yaml",
+			file: "project.yaml",
+			content: `name: billing
+module: github.com/our-org/billing
+template: minimal
+ci: none
+git:
+  init: false
+license: MIT
+`,
+			want: ProjectOptions{
+				ProjectName: "billing",
+				ModulePath:  "github.com/our-org/billing",
+				Template:    TemplateMinimal,
+				Database:    DefaultDBDriver,
+				CI:          "none",
+				License:     "MIT",
+			},
+		},
+		{
+			name:    "json keeps defaults",
+			file:    "project.json",
+			content: `{"name": "billing", "template": "graphql"}`,
+			want: ProjectOptions{
+				ProjectName: "billing",
+				Template:    TemplateGraphQL,
+				Database:    DefaultDBDriver,
+				CI:          DefaultCIProvider,
+				Git:         GitOptions{Init: true},
+				License:     "none",
+			},
+		},
+		{
+			name:    "unknown yaml field",
+			file:    "typo.yaml",
+			content: "name: billing\ntempalte: minimal\n",
+			wantErr: "field tempalte not found",
+		},
+		{
+			name:    "unknown json field",
+			file:    "typo.json",
+			content: `{"name": "billing", "tempalte": "minimal"}`,
+			wantErr: `unknown field "tempalte"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, tt.file)
+			if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := LoadProjectConfig(path)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("LoadProjectConfig() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadProjectConfig() error = %v", err)
+			}
+			if got.ProjectName != tt.want.ProjectName || got.ModulePath != tt.want.ModulePath ||
+				got.Template != tt.want.Template || got.Database != tt.want.Database ||
+				got.CI != tt.want.CI || got.Git != tt.want.Git || got.License != tt.want.License {
+				t.Errorf("LoadProjectConfig() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadProjectConfigMissingFile(t *testing.T) {
+	if _, err := LoadProjectConfig(filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+		t.Error("LoadProjectConfig() should fail for a missing file")
+	}
+}
+
+// TestLoadProjectConfigEmptyFile tests that an empty or comment-only spec
+// keeps the default options
+func TestLoadProjectConfigEmptyFile(t *testing.T) {
+	for name, content := range map[string]string{"empty.yaml": "", "comments.yaml": "# nothing yet\n", "empty.json": ""} {
+		path := filepath.Join(t.TempDir(), name)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		got, err := LoadProjectConfig(path)
+		if err != nil {
+			t.Errorf("LoadProjectConfig(%s) error = %v", name, err)
+			continue
+		}
+		if want := DefaultProjectOptions(); got.Template != want.Template || got.ProjectName != "" {
+			t.Errorf("LoadProjectConfig(%s) = %+v, want the defaults", name, got)
+		}
+	}
+}
+
+func TestProjectSpecFileRoundTrip(t *testing.T) {
+	opts := testProjectOptions("billing", TemplateMinimal)
+	opts.ModulePath = "github.com/our-org/billing"
+
+	spec, err := projectSpecFile("billing", opts)
+	if err != nil {
+		t.Fatalf("projectSpecFile() error = %v", err)
+	}
+	if spec.Path != filepath.Join("billing", ".go-starter", "project.yaml") {
+		t.Errorf("spec path = %s", spec.Path)
+	}
+
+	path := filepath.Join(t.TempDir(), "project.yaml")
+	if err := os.WriteFile(path, []byte(spec.Content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadProjectConfig(path)
+	if err != nil {
+		t.Fatalf("LoadProjectConfig() error = %v\nspec:\n%s", err, spec.Content)
+	}
+	if loaded.ProjectName != opts.ProjectName || loaded.ModulePath != opts.ModulePath || loaded.Template != opts.Template || loaded.Git != opts.Git {
+		t.Errorf("round trip = %+v, want %+v", loaded, opts)
+	}
+}
@@ -0,0 +1,85 @@
+package generator
+
+import "fmt"
+
+// Database describes a database driver a project can be generated with
+// (--database). The driver-specific code lives in the templates/partials/database
+// snippets; the values shared by several files are declared here.
+type Database struct {
+	// Name is the value accepted by the --database flag
+	Name string
+	// Title is the display name used in comments and instructions
+	Title string
+	// Description is the one-line summary shown in the help output
+	Description string
+	// Driver is the GORM driver module added to go.mod
+	Driver GoRequire
+	// Image is the Docker image of the database server, empty for embedded databases
+	Image string
+	// Port is the port the database server listens on
+	Port string
+}
+
+// Server reports whether the database runs as a separate server
+func (db *Database) Server() bool {
+	return db.Image != ""
+}
+
+// databases lists the supported database drivers, default first
+var databases = []*Database{
+	{
+		Name:        "postgres",
+		Title:       "PostgreSQL",
+		Description: "PostgreSQL server (default)",
+		Driver:      GoRequire{"gorm.io/driver/postgres", "v1.5.11"},
+		Image:       "postgres:16-alpine",
+		Port:        "5432",
+	},
+	{
+		Name:        "mysql",
+		Title:       "MySQL",
+		Description: "MySQL or MariaDB server",
+		Driver:      GoRequire{"gorm.io/driver/mysql", "v1.6.0"},
+		Image:       "mysql:8.4",
+		Port:        "3306",
+	},
+	{
+		Name:        "sqlite",
+		Title:       "SQLite",
+		Description: "SQLite file, pure Go driver (prototypes and hermetic tests)",
+		Driver:      GoRequire{"github.com/glebarez/sqlite", "v1.11.0"},
+	},
+}
+
+// Databases returns the supported database drivers, default first
+func Databases() []*Database {
+	return append([]*Database(nil), databases...)
+}
+
+// LookupDatabase returns the supported database driver with the given name
+func LookupDatabase(name string) (*Database, bool) {
+	for _, db := range databases {
+		if db.Name == name {
+			return db, true
+		}
+	}
+	return nil, false
+}
+
+// databaseNames returns the names of the supported database drivers
+func databaseNames() []string {
+	names := make([]string, len(databases))
+	for i, db := range databases {
+		names[i] = db.Name
+	}
+	return names
+}
+
+// Database returns the database driver of the project
+func (d TemplateData) Database() (*Database, error) {
+	db, ok := LookupDatabase(d.DBDriver)
+	if !ok {
+		return nil, fmt.Errorf("unknown database driver '%s'", d.DBDriver)
+	}
+	return db, nil
+}
@@ -0,0 +1,93 @@
+package generator
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestDatabaseDrivers tests that each driver switches the files that depend on it together
+func TestDatabaseDrivers(t *testing.T) {
+	tests := []struct {
+		database string
+		want     map[string][]string
+		unwanted map[string][]string
+	}{
+		{
+			database: "postgres",
+			want: map[string][]string{
+				"go.mod": {"\tgorm.io/driver/postgres v1.5.11\n"},
+				"internal/infrastructure/database/database.go": {"\t\"gorm.io/driver/postgres\"\n", "gorm.Open(postgres.Open(dsn)", "sslmode=%s"},
+				".env.example":             {"DB_PORT=5432\n", "DB_SSLMODE=disable\n"},
+				"docker-compose.yml":       {"image: postgres:16-alpine", "postgres_data:"},
+				".github/workflows/ci.yml": {"      postgres:\n", "--health-cmd pg_isready"},
+			},
+		},
+		{
+			database: "mysql",
+			want: map[string][]string{
+				"go.mod": {"\tgorm.io/driver/mysql v1.6.0\n"},
+				"internal/infrastructure/database/database.go": {"\t\"gorm.io/driver/mysql\"\n", "gorm.Open(mysql.Open(dsn)", "@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True"},
+				".env.example":             {"DB_PORT=3306\n", "DB_USER=app\n"},
+				"docker-compose.yml":       {"image: mysql:8.4", "MYSQL_DATABASE: db-app", "mysql_data:"},
+				".github/workflows/ci.yml": {"      mysql:\n", "mysqladmin ping"},
+				"setup.sh":                 {"Configuration de MySQL", "--name mysql"},
+				"README.md":                {"### 3. Lancer MySQL", "MYSQL_DATABASE=db-app"},
+			},
+			unwanted: map[string][]string{
+				"go.mod":             {"gorm.io/driver/postgres"},
+				".env.example":       {"DB_SSLMODE"},
+				"docker-compose.yml": {"postgres"},
+			},
+		},
+		{
+			database: "sqlite",
+			want: map[string][]string{
+				"go.mod": {"\tgithub.com/glebarez/sqlite v1.11.0\n"},
+				"internal/infrastructure/database/database.go": {"\t\"github.com/glebarez/sqlite\"\n", "gorm.Open(sqlite.Open(dsn)", `config.GetEnv("DB_PATH", "db-app.db")`},
+				".env.example":       {"DB_PATH=db-app.db\n"},
+				"docker-compose.yml": {"DB_PATH: /app/data/db-app.db", "sqlite_data:/app/data"},
+				"Dockerfile":         {"mkdir -p /app/data"},
+				".gitignore":         {"*.db\n"},
+				"setup.sh":           {"Configuration de SQLite"},
+			},
+			unwanted: map[string][]string{
+				"go.mod":                   {"gorm.io/driver/"},
+				".env.example":             {"DB_HOST"},
+				"docker-compose.yml":       {"depends_on", "  db:\n"},
+				".github/workflows/ci.yml": {"services:", "DB_HOST"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.database, func(t *testing.T) {
+			opts := testProjectOptions("db-app", TemplateFull)
+			opts.Database = tt.database
+			files, err := projectFiles("db-app", opts)
+			if err != nil {
+				t.Fatalf("projectFiles() error = %v", err)
+			}
+			generated := make(map[string]string)
+			for _, file := range files {
+				rel, _ := filepath.Rel("db-app", file.Path)
+				generated[filepath.ToSlash(rel)] = file.Content
+			}
+
+			for path, wants := range tt.want {
+				for _, want := range wants {
+					if !strings.Contains(generated[path], want) {
+						t.Errorf("%s should contain %q, got:\n%s", path, want, generated[path])
+					}
+				}
+			}
+			for path, unwanted := range tt.unwanted {
+				for _, s := range unwanted {
+					if strings.Contains(generated[path], s) {
+						t.Errorf("%s should not contain %q", path, s)
+					}
+				}
+			}
+		})
+	}
+}
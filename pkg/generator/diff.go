@@ -0,0 +1,163 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffContextLines is the number of unchanged lines shown around each change
+const diffContextLines = 3
+
+// diffOp is the kind of a line in an edit script
+type diffOp int
+
+const (
+	diffEqual diffOp = iota
+	diffDelete
+	diffInsert
+)
+
+// diffLine is a single line of an edit script
+type diffLine struct {
+	op   diffOp
+	text string
+}
+
+// splitLines splits text into lines, keeping the trailing newline of each line
+// so that a missing final newline shows up in the diff.
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// diffLines computes the edit script turning a into b using a longest common
+// subsequence table. Template files are a few thousand lines at most, so the
+// quadratic table stays small.
+func diffLines(a, b []string) []diffLine {
+	n, m := len(a), len(b)
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	script := make([]diffLine, 0, n+m)
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case a[i] == b[j]:
+			script = append(script, diffLine{diffEqual, a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			script = append(script, diffLine{diffDelete, a[i]})
+			i++
+		default:
+			script = append(script, diffLine{diffInsert, b[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		script = append(script, diffLine{diffDelete, a[i]})
+	}
+	for ; j < m; j++ {
+		script = append(script, diffLine{diffInsert, b[j]})
+	}
+	return script
+}
+
+// UnifiedDiff returns a unified diff between oldText and newText, or an empty
+// string when they are identical.
+func UnifiedDiff(oldName, newName, oldText, newText string) string {
+	if oldText == newText {
+		return ""
+	}
+	script := diffLines(splitLines(oldText), splitLines(newText))
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- %s\n+++ %s\n", oldName, newName)
+
+	// Walk the script and emit one hunk per group of changes closer than
+	// 2*diffContextLines lines to each other.
+	for start := 0; start < len(script); {
+		if script[start].op == diffEqual {
+			start++
+			continue
+		}
+
+		hunkStart := max(start-diffContextLines, 0)
+		hunkEnd := start
+		for k := start; k < len(script); k++ {
+			if script[k].op != diffEqual {
+				hunkEnd = k + 1
+				continue
+			}
+			if k-hunkEnd >= 2*diffContextLines {
+				break
+			}
+		}
+		hunkEnd = min(hunkEnd+diffContextLines, len(script))
+
+		oldLine, newLine := 1, 1
+		for _, l := range script[:hunkStart] {
+			if l.op != diffInsert {
+				oldLine++
+			}
+			if l.op != diffDelete {
+				newLine++
+			}
+		}
+		oldCount, newCount := 0, 0
+		for _, l := range script[hunkStart:hunkEnd] {
+			if l.op != diffInsert {
+				oldCount++
+			}
+			if l.op != diffDelete {
+				newCount++
+			}
+		}
+		fmt.Fprintf(&b, "@@ -%s +%s @@\n", hunkRange(oldLine, oldCount), hunkRange(newLine, newCount))
+
+		for _, l := range script[hunkStart:hunkEnd] {
+			prefix := " "
+			switch l.op {
+			case diffDelete:
+				prefix = "-"
+			case diffInsert:
+				prefix = "+"
+			}
+			b.WriteString(prefix + l.text)
+			if !strings.HasSuffix(l.text, "\n") {
+				b.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+		start = hunkEnd
+	}
+	return b.String()
+}
+
+// hunkRange formats the "start,count" part of a hunk header
+func hunkRange(start, count int) string {
+	if count == 0 {
+		// An empty range points at the line before the change
+		return fmt.Sprintf("%d,0", start-1)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start)
+	}
+	return fmt.Sprintf("%d,%d", start, count)
+}
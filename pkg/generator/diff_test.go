@@ -0,0 +1,51 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUnifiedDiffIdentical(t *testing.T) {
+	if diff := UnifiedDiff("a", "b", "same\n", "same\n"); diff != "" {
+		t.Errorf("UnifiedDiff() of identical texts = %q, want empty", diff)
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	oldText := "line1\nline2\nline3\nline4\nline5\nline6\nline7\nline8\nline9\nline10\n"
+	newText := "line1\nline2\nchanged\nline4\nline5\nline6\nline7\nline8\nline9\nline10\nline11\n"
+
+	want := `--- a/file
++++ b/file
+@@ -1,6 +1,6 @@
+ line1
+ line2
+-line3
++changed
+ line4
+ line5
+ line6
+@@ -8,3 +8,4 @@
+ line8
+ line9
+ line10
++line11
+`
+	if got := UnifiedDiff("a/file", "b/file", oldText, newText); got != want {
+		t.Errorf("UnifiedDiff() =\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestUnifiedDiffNewFile(t *testing.T) {
+	diff := UnifiedDiff("/dev/null", "b/new", "", "a\nb\n")
+	if !strings.Contains(diff, "@@ -0,0 +1,2 @@\n+a\n+b\n") {
+		t.Errorf("UnifiedDiff() for a new file = %q", diff)
+	}
+}
+
+func TestUnifiedDiffMissingNewline(t *testing.T) {
+	diff := UnifiedDiff("a", "b", "x\n", "x")
+	if !strings.Contains(diff, "\\ No newline at end of file") {
+		t.Errorf("UnifiedDiff() should report the missing final newline, got: %q", diff)
+	}
+}
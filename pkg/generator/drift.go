@@ -0,0 +1,179 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"unicode/utf8"
+)
+
+// DriftStatus tells how a generated file compares with the template
+type DriftStatus string
+
+// Drift statuses
+const (
+	// DriftUntouched files are identical to the template
+	DriftUntouched DriftStatus = "untouched"
+	// DriftModified files were edited since generation
+	DriftModified DriftStatus = "modified"
+	// DriftDeleted files were generated and deleted since
+	DriftDeleted DriftStatus = "deleted"
+	// DriftNew files are rendered by the current templates but were not
+	// generated with the project: upgrade adds them. Only a fresh render,
+	// without base archive, finds them.
+	DriftNew DriftStatus = "new"
+)
+
+// FileDrift is the comparison of a generated file with the template
+type FileDrift struct {
+	// Path is relative to the project root, with forward slashes
+	Path string `json:"path"`
+	// Status tells whether the file was modified, deleted or left untouched
+	Status DriftStatus `json:"status"`
+	// Diff is the unified diff from the template to the project file, set
+	// for modified files
+	Diff string `json:"diff,omitempty"`
+}
+
+// Baselines a project is compared with
+const (
+	// DriftBaselineGenerated is the files as generated, archived in the project
+	DriftBaselineGenerated = "generated"
+	// DriftBaselineRendered is a fresh render with the current templates, for
+	// projects without a base archive
+	DriftBaselineRendered = "rendered"
+)
+
+// DriftResult lists how far a project has drifted from its template
+type DriftResult struct {
+	// Template is the template the project was generated from
+	Template string `json:"template"`
+	// FromVersion and FromTemplates are the versions the project was generated with
+	FromVersion   string `json:"generator_version"`
+	FromTemplates string `json:"templates_version"`
+	// Baseline is what the files are compared with: DriftBaselineGenerated or
+	// DriftBaselineRendered
+	Baseline string `json:"baseline"`
+	// StaleArchive reports that the base archive does not match the hashes of
+	// the manifest: the project was compared with a fresh render instead
+	StaleArchive bool `json:"stale_archive"`
+	// TemplatesChanged reports that the templates changed since generation:
+	// upgrade has changes to bring. Compared with a fresh render, the template
+	// changes show up as drift too.
+	TemplatesChanged bool `json:"templates_changed"`
+	// Files lists every generated file, sorted by path
+	Files []FileDrift `json:"files"`
+}
+
+// Count returns the number of files with the given status
+func (r *DriftResult) Count(status DriftStatus) int {
+	n := 0
+	for _, f := range r.Files {
+		if f.Status == status {
+			n++
+		}
+	}
+	return n
+}
+
+// Drifted reports whether a generated file was modified or deleted
+func (r *DriftResult) Drifted() bool {
+	return r.Count(DriftModified)+r.Count(DriftDeleted) > 0
+}
+
+// Drift compares every generated file of the project in projectPath with the
+// file as generated, archived in the project, so that changes made to the
+// templates since generation are not reported as drift. With rendered, or
+// when the project has no base archive or one that does not match the hashes
+// of the manifest, the files are compared with a render in memory of the
+// current templates, with the options recorded in the manifest. A change of
+// templates since generation is reported either way. Nothing is written.
+// Files added to the project are not listed: they were never generated.
+func Drift(projectPath string, rendered bool) (*DriftResult, error) {
+	manifest, err := LoadManifest(projectPath)
+	if err != nil {
+		return nil, err
+	}
+	result := &DriftResult{
+		Template:         manifest.Template,
+		FromVersion:      manifest.GeneratorVersion,
+		FromTemplates:    manifest.TemplatesVersion,
+		Baseline:         DriftBaselineGenerated,
+		TemplatesChanged: manifest.TemplatesVersion != TemplatesVersion(),
+	}
+	var contents map[string]string
+	if !rendered {
+		if contents, err = readBaseArchive(projectPath); err != nil {
+			return nil, err
+		}
+		if len(contents) > 0 && !archiveMatches(manifest, contents) {
+			result.StaleArchive = true
+			contents = nil
+		}
+	}
+	if len(contents) == 0 {
+		_, _, files, err := renderManifestProject(projectPath, manifest)
+		if err != nil {
+			return nil, err
+		}
+		if contents, err = renderedContents(projectPath, files); err != nil {
+			return nil, err
+		}
+		result.Baseline = DriftBaselineRendered
+	}
+
+	for _, p := range slices.Sorted(maps.Keys(contents)) {
+		drift, err := fileDrift(projectPath, p, contents[p], manifest)
+		if err != nil {
+			return nil, err
+		}
+		result.Files = append(result.Files, drift)
+	}
+	return result, nil
+}
+
+// archiveMatches reports whether the base archive contents hold the files
+// listed in the manifest, with the hashes recorded there. Manifests without
+// hashes cannot tell.
+func archiveMatches(manifest *Manifest, contents map[string]string) bool {
+	if len(manifest.Files) == 0 {
+		return true
+	}
+	if len(contents) != len(manifest.Files) {
+		return false
+	}
+	for p, content := range contents {
+		if manifest.Files[p] != hashContent([]byte(content)) {
+			return false
+		}
+	}
+	return true
+}
+
+// fileDrift compares the project file at p with its content in the baseline
+func fileDrift(projectPath, p, baseline string, manifest *Manifest) (FileDrift, error) {
+	current, err := os.ReadFile(filepath.Join(projectPath, filepath.FromSlash(p)))
+	if errors.Is(err, fs.ErrNotExist) {
+		if _, generated := manifest.Files[p]; !generated && len(manifest.Files) > 0 {
+			return FileDrift{Path: p, Status: DriftNew}, nil
+		}
+		return FileDrift{Path: p, Status: DriftDeleted}, nil
+	}
+	if err != nil {
+		return FileDrift{}, fmt.Errorf("failed to read %s: %w", p, err)
+	}
+	if string(current) == baseline {
+		return FileDrift{Path: p, Status: DriftUntouched}, nil
+	}
+
+	oldName, newName := "template/"+p, "project/"+p
+	diff := fmt.Sprintf("Binary files %s and %s differ\n", oldName, newName)
+	if utf8.Valid(current) {
+		diff = UnifiedDiff(oldName, newName, baseline, string(current))
+	}
+	return FileDrift{Path: p, Status: DriftModified, Diff: diff}, nil
+}
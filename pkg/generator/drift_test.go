@@ -0,0 +1,172 @@
+package generator
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestDrift tests that drift reports the modified, deleted, new and untouched
+// files of a project, with the diff of the modified ones
+func TestDrift(t *testing.T) {
+	projectPath := writeTestProject(t, testProjectOptions("drift-app", TemplateMinimal))
+
+	result, err := Drift(projectPath, false)
+	if err != nil {
+		t.Fatalf("Drift() error = %v", err)
+	}
+	if result.Drifted() || result.TemplatesChanged || result.Template != TemplateMinimal || result.Count(DriftUntouched) != len(result.Files) {
+		t.Errorf("a freshly generated project should not drift: %+v", result)
+	}
+
+	dockerfile := filepath.Join(projectPath, "Dockerfile")
+	content, err := os.ReadFile(dockerfile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(dockerfile, []byte(strings.Replace(string(content), "USER appuser\n", "", 1)), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(filepath.Join(projectPath, "Makefile")); err != nil {
+		t.Fatal(err)
+	}
+	// A file the manifest does not list comes from newer templates: a fresh
+	// render, without base archive, finds it
+	m, err := LoadManifest(projectPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	delete(m.Files, "README.md")
+	manifest, _ := json.Marshal(m)
+	if err := os.WriteFile(filepath.Join(projectPath, ManifestPath), manifest, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(filepath.Join(projectPath, "README.md")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(filepath.Join(projectPath, baseArchivePath)); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err = Drift(projectPath, false)
+	if err != nil {
+		t.Fatalf("Drift() error = %v", err)
+	}
+	if !result.Drifted() || result.Baseline != DriftBaselineRendered {
+		t.Errorf("Drifted() = %v with baseline %q, want drift against a fresh render", result.Drifted(), result.Baseline)
+	}
+	statuses := map[string]DriftStatus{}
+	for _, f := range result.Files {
+		statuses[f.Path] = f.Status
+		if f.Status != DriftModified && f.Diff != "" {
+			t.Errorf("%s is %s but has a diff", f.Path, f.Status)
+		}
+		if f.Path == "Dockerfile" && (!strings.HasPrefix(f.Diff, "--- template/Dockerfile\n+++ project/Dockerfile\n") || !strings.Contains(f.Diff, "\n-USER appuser\n")) {
+			t.Errorf("Dockerfile diff = %q, want the removed USER line", f.Diff)
+		}
+	}
+	want := map[string]DriftStatus{"Dockerfile": DriftModified, "Makefile": DriftDeleted, "README.md": DriftNew, "go.mod": DriftUntouched}
+	for p, status := range want {
+		if statuses[p] != status {
+			t.Errorf("%s status = %q, want %q", p, statuses[p], status)
+		}
+	}
+	if _, ok := statuses[ManifestPath]; ok {
+		t.Errorf("the manifest is not a template file")
+	}
+
+	if _, err := Drift(t.TempDir(), false); !errors.Is(err, ErrNoManifest) {
+		t.Errorf("Drift() error = %v, want ErrNoManifest", err)
+	}
+}
+
+// TestDriftIgnoresTemplateChanges tests that a project is compared with the
+// files as generated, not with templates changed since generation, unless a
+// fresh render is asked for, and that the template change is reported
+func TestDriftIgnoresTemplateChanges(t *testing.T) {
+	projectPath := writeTestProject(t, testProjectOptions("drift-old", TemplateMinimal))
+	base, err := readBaseArchive(projectPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The project was generated by older templates with another Makefile,
+	// then its Dockerfile was edited
+	base["Makefile"] = "build:\n\tgo build ./...\n"
+	writeGeneration(t, projectPath, base)
+	for p, content := range map[string]string{"Makefile": base["Makefile"], "Dockerfile": base["Dockerfile"] + "# edited\n"} {
+		if err := os.WriteFile(filepath.Join(projectPath, p), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	result, err := Drift(projectPath, false)
+	if err != nil {
+		t.Fatalf("Drift() error = %v", err)
+	}
+	if result.Baseline != DriftBaselineGenerated || !result.TemplatesChanged || result.StaleArchive {
+		t.Errorf("result = %+v, want the files as generated as baseline and the template change reported", result)
+	}
+	for _, f := range result.Files {
+		want := DriftUntouched
+		if f.Path == "Dockerfile" {
+			want = DriftModified
+		}
+		if f.Status != want {
+			t.Errorf("%s status = %q, want %q", f.Path, f.Status, want)
+		}
+	}
+	if len(result.Files) != len(base) {
+		t.Errorf("Drift() listed %d files, want the %d files of the base archive", len(result.Files), len(base))
+	}
+
+	// Compared with the current templates, the old Makefile drifted too
+	result, err = Drift(projectPath, true)
+	if err != nil {
+		t.Fatalf("Drift() rendered error = %v", err)
+	}
+	if result.Baseline != DriftBaselineRendered || !result.TemplatesChanged {
+		t.Errorf("result = %+v, want a fresh render as baseline", result)
+	}
+	for _, f := range result.Files {
+		if (f.Path == "Makefile" || f.Path == "Dockerfile") && f.Status != DriftModified {
+			t.Errorf("%s status = %q against the current templates, want modified", f.Path, f.Status)
+		}
+	}
+}
+
+// TestDriftStaleArchive tests that a base archive that does not match the
+// manifest hashes is not trusted: the project is compared with a fresh render
+func TestDriftStaleArchive(t *testing.T) {
+	projectPath := writeTestProject(t, testProjectOptions("drift-stale", TemplateMinimal))
+	base, err := readBaseArchive(projectPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	base["Dockerfile"] = "FROM scratch\n"
+	var files []FileGenerator
+	for p, content := range base {
+		files = append(files, FileGenerator{Path: filepath.Join(projectPath, filepath.FromSlash(p)), Content: content})
+	}
+	archive, err := baseArchive(projectPath, files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(projectPath, baseArchivePath), archive, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := Drift(projectPath, false)
+	if err != nil {
+		t.Fatalf("Drift() error = %v", err)
+	}
+	if result.Baseline != DriftBaselineRendered || !result.StaleArchive || result.TemplatesChanged {
+		t.Errorf("result = %+v, want a fresh render as baseline for a stale archive", result)
+	}
+	if result.Drifted() {
+		t.Errorf("the project is as generated, got drift: %+v", result.Files)
+	}
+}
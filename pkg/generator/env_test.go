@@ -0,0 +1,88 @@
+package generator
+
+import "testing"
+
+// TestCopyEnvFile verifies that .env.example is correctly copied to .env
+func TestCopyEnvFile(t *testing.T) {
+	envExampleContent := "APP_NAME=test\nAPP_PORT=8080\n"
+	files := map[string]string{".env.example": envExampleContent}
+
+	// Test copying .env.example to .env
+	if err := copyEnvFile(files); err != nil {
+		t.Fatalf("copyEnvFile() failed: %v", err)
+	}
+
+	// Verify .env content matches .env.example
+	envContent, ok := files[".env"]
+	if !ok {
+		t.Fatal(".env file was not created")
+	}
+	if envContent != envExampleContent {
+		t.Errorf("Expected .env content to match .env.example\nGot: %s\nWant: %s", envContent, envExampleContent)
+	}
+}
+
+// TestCopyEnvFileSkipsIfExists verifies that existing .env files are not overwritten
+func TestCopyEnvFileSkipsIfExists(t *testing.T) {
+	existingContent := "APP_NAME=existing\nAPP_SECRET=secret123\n"
+	files := map[string]string{
+		".env.example": "APP_NAME=example\n",
+		".env":         existingContent,
+	}
+
+	// Attempt to copy - should skip
+	if err := copyEnvFile(files); err != nil {
+		t.Fatalf("copyEnvFile() failed: %v", err)
+	}
+
+	// Verify .env was NOT overwritten
+	if files[".env"] != existingContent {
+		t.Errorf("Existing .env file was overwritten\nGot: %s\nWant: %s", files[".env"], existingContent)
+	}
+}
+
+// TestCopyEnvFileErrorsIfNoExample verifies appropriate error when .env.example is missing
+func TestCopyEnvFileErrorsIfNoExample(t *testing.T) {
+	// Do NOT create .env.example
+	err := copyEnvFile(map[string]string{"go.mod": "module test\n"})
+	if err == nil {
+		t.Fatal("Expected error when .env.example is missing, but got nil")
+	}
+}
+
+// TestEnvTemplateContainsRequiredVariables verifies .env.example has all required variables
+func TestEnvTemplateContainsRequiredVariables(t *testing.T) {
+	templates := NewProjectTemplates("test-project")
+	envContent := templates.EnvTemplate()
+
+	requiredVars := []string{
+		"APP_NAME=",
+		"APP_PORT=",
+		"DB_HOST=",
+		"DB_PORT=",
+		"DB_USER=",
+		"DB_PASSWORD=",
+		"DB_NAME=",
+		"JWT_SECRET=",
+	}
+
+	for _, reqVar := range requiredVars {
+		if !contains(envContent, reqVar) {
+			t.Errorf(".env.example template missing required variable: %s", reqVar)
+		}
+	}
+}
+
+// contains is a helper function to check if a string contains a substring
+func contains(s, substr string) bool {
+	return len(s) >= len(substr) && (s == substr || len(s) > len(substr) && findSubstring(s, substr))
+}
+
+func findSubstring(s, substr string) bool {
+	for i := 0; i <= len(s)-len(substr); i++ {
+		if s[i:i+len(substr)] == substr {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,449 @@
+package generator
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+)
+
+// Feature is an optional capability layered on top of a base template
+// (--features). Its files live in the features/<name> layer of the template
+// tree; everything it adds to shared files (go.mod, cmd/main.go, the server
+// routes, .env.example and docker-compose.yml) is declared here and rendered by
+// the base templates.
+type Feature struct {
+	// Name is the value accepted by the --features flag
+	Name string
+	// Description is the one-line summary shown in the help output
+	Description string
+	// Requires lists the features enabled along with this one
+	Requires []string
+	// Conflicts lists the features that cannot be enabled with this one. The
+	// built-in features all combine: it is meant for features registered by
+	// library users, for example two alternative implementations of a service.
+	Conflicts []string
+	// GoRequires lists the requirements added to go.mod
+	GoRequires []GoRequire
+	// FrameworkGoRequires lists, by HTTP router, the additional requirements
+	// of the router-specific feature files (e.g. a router's Swagger handler)
+	FrameworkGoRequires map[string][]GoRequire
+	// Modules lists the fx modules added to cmd/main.go
+	Modules []FxModule
+	// Routes lists the route registration functions of internal/adapters/http
+	// invoked by the server module
+	Routes []string
+	// Models lists the types of internal/models migrated at startup
+	Models []string
+	// EnvComment is the comment written above the feature keys in .env.example
+	EnvComment []string
+	// Env lists the keys added to .env.example
+	Env []EnvVar
+	// ComposeEnv lists the environment added to the api service of
+	// docker-compose.yml, with values for the compose network
+	ComposeEnv []EnvVar
+	// ComposeServices lists the services added to docker-compose.yml, which
+	// the api service waits for
+	ComposeServices []ComposeService
+}
+
+// GoRequire is a go.mod requirement
+type GoRequire struct {
+	Path    string
+	Version string
+}
+
+// String returns the requirement as written in go.mod ("path version")
+func (r GoRequire) String() string {
+	return r.Path + " " + r.Version
+}
+
+// FxModule is an fx module wired in cmd/main.go
+type FxModule struct {
+	// Package is the package path relative to the module root (e.g. "pkg/auth")
+	Package string
+	// Comment is the comment written above the module in fx.New
+	Comment string
+}
+
+// Name returns the package name the module is referenced by
+func (m FxModule) Name() string {
+	return path.Base(m.Package)
+}
+
+// EnvVar is a key of .env.example with its default value
+type EnvVar struct {
+	Key   string
+	Value string
+}
+
+// ComposeService is a service of docker-compose.yml the api service depends on
+type ComposeService struct {
+	// Name is the service name, also its host name on the compose network
+	Name string
+	// Comment is the comment written above the service
+	Comment string
+	// Image is the container image
+	Image string
+	// Port is the port published on the host
+	Port string
+	// Healthcheck is the test command telling that the service is ready
+	Healthcheck []string
+}
+
+// layer returns the template tree layer holding the feature files, or an empty
+// string when the feature only adds to shared files
+func (f *Feature) layer() string {
+	layer := path.Join("features", f.Name)
+	if _, err := fs.Stat(templateFS, path.Join(templateRoot, layer)); err != nil {
+		return ""
+	}
+	return layer
+}
+
+// registeredFeatures holds the features in registration order
+var registeredFeatures []*Feature
+
+// RegisterFeature adds a feature to the registry.
+// It panics if the name is empty or already registered, as this is a programming error.
+func RegisterFeature(f *Feature) {
+	if f.Name == "" {
+		panic("feature name cannot be empty")
+	}
+	if _, ok := LookupFeature(f.Name); ok {
+		panic(fmt.Sprintf("feature '%s' is already registered", f.Name))
+	}
+	registeredFeatures = append(registeredFeatures, f)
+}
+
+// LookupFeature returns the registered feature with the given name
+func LookupFeature(name string) (*Feature, bool) {
+	for _, f := range registeredFeatures {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return nil, false
+}
+
+// Features returns all registered features in registration order
+func Features() []*Feature {
+	return append([]*Feature(nil), registeredFeatures...)
+}
+
+// ValidFeatures returns the names of all registered features
+func ValidFeatures() []string {
+	names := make([]string, 0, len(registeredFeatures))
+	for _, f := range registeredFeatures {
+		names = append(names, f.Name)
+	}
+	return names
+}
+
+// FeatureTemplate is implemented by templates optional features can be layered on
+type FeatureTemplate interface {
+	Template
+	// BuiltinFeatures lists the features the template always includes
+	BuiltinFeatures() []string
+}
+
+// ResolveFeatures returns the features enabled for a project: the features
+// built into the template, then the requested ones, each preceded by the
+// features it requires. It rejects unknown features, templates that do not
+// support features and conflicting combinations.
+func ResolveFeatures(template string, requested []string) ([]string, error) {
+	var builtin []string
+	if tmpl, ok := LookupTemplate(template); ok {
+		if ft, ok := tmpl.(FeatureTemplate); ok {
+			builtin = ft.BuiltinFeatures()
+		} else if len(requested) > 0 {
+			return nil, fmt.Errorf("template '%s' does not support optional features", template)
+		}
+	}
+	return resolveFeatureList(append(builtin, requested...))
+}
+
+// resolveFeatureList returns names with the features each one requires added
+// before it, and checks the result for conflicts. Resolving an already
+// resolved list returns it unchanged.
+func resolveFeatureList(names []string) ([]string, error) {
+	var resolved []string
+	var visit func(name string, chain []string) error
+	visit = func(name string, chain []string) error {
+		if slices.Contains(resolved, name) {
+			return nil
+		}
+		if slices.Contains(chain, name) {
+			return fmt.Errorf("feature dependency cycle: %s", strings.Join(append(chain, name), " -> "))
+		}
+		f, ok := LookupFeature(name)
+		if !ok {
+			if len(chain) > 0 {
+				return fmt.Errorf("feature '%s' requires unknown feature '%s'", chain[len(chain)-1], name)
+			}
+			return fmt.Errorf("unknown feature '%s': valid options are: %s", name, strings.Join(ValidFeatures(), ", "))
+		}
+		chain = append(slices.Clip(chain), name)
+		for _, dep := range f.Requires {
+			if err := visit(dep, chain); err != nil {
+				return err
+			}
+		}
+		resolved = append(resolved, name)
+		return nil
+	}
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, name := range resolved {
+		f, _ := LookupFeature(name)
+		for _, other := range f.Conflicts {
+			if slices.Contains(resolved, other) {
+				return nil, fmt.Errorf("feature '%s' conflicts with feature '%s'", name, other)
+			}
+		}
+	}
+	return resolved, nil
+}
+
+// ParseFeatureList splits a comma-separated feature list; "none" means no feature
+func ParseFeatureList(answer string) []string {
+	var features []string
+	for _, f := range strings.Split(answer, ",") {
+		f = strings.TrimSpace(f)
+		if f == "" || f == "none" || slices.Contains(features, f) {
+			continue
+		}
+		features = append(features, f)
+	}
+	return features
+}
+
+// enabledFeatures returns the registered features named in names
+func enabledFeatures(names []string) []*Feature {
+	var features []*Feature
+	for _, name := range names {
+		if f, ok := LookupFeature(name); ok {
+			features = append(features, f)
+		}
+	}
+	return features
+}
+
+// GoRequires returns the go.mod requirements added by the HTTP router and the
+// enabled features
+func (d TemplateData) GoRequires() []GoRequire {
+	var requires []GoRequire
+	if fw, ok := lookupFramework(d.HTTPFramework); ok {
+		requires = append(requires, fw.GoRequires...)
+	}
+	for _, f := range enabledFeatures(d.Features) {
+		requires = append(requires, f.GoRequires...)
+		requires = append(requires, f.FrameworkGoRequires[d.HTTPFramework]...)
+	}
+	return requires
+}
+
+// Requires returns the base go.mod requirements ("path version") merged with
+// the ones of the HTTP router and the enabled features, sorted by module path.
+// A base requirement wins over an added requirement of the same module.
+func (d TemplateData) Requires(base ...string) []string {
+	requires := slices.Clone(base)
+	for _, r := range d.GoRequires() {
+		listed := slices.ContainsFunc(requires, func(req string) bool {
+			return strings.Fields(req)[0] == r.Path
+		})
+		if !listed {
+			requires = append(requires, r.String())
+		}
+	}
+	slices.Sort(requires)
+	return requires
+}
+
+// FxModules returns the fx modules added by the enabled features
+func (d TemplateData) FxModules() []FxModule {
+	var modules []FxModule
+	for _, f := range enabledFeatures(d.Features) {
+		modules = append(modules, f.Modules...)
+	}
+	return modules
+}
+
+// Imports returns the packages of base and of the enabled features' fx
+// modules, sorted, as import paths of the project module
+func (d TemplateData) Imports(base ...string) []string {
+	pkgs := slices.Clone(base)
+	for _, m := range d.FxModules() {
+		pkgs = append(pkgs, m.Package)
+	}
+	slices.Sort(pkgs)
+	pkgs = slices.Compact(pkgs)
+
+	imports := make([]string, len(pkgs))
+	for i, pkg := range pkgs {
+		imports[i] = path.Join(d.ModulePath, pkg)
+	}
+	return imports
+}
+
+// Routes returns the route registration functions added by the enabled features
+func (d TemplateData) Routes() []string {
+	var routes []string
+	for _, f := range enabledFeatures(d.Features) {
+		routes = append(routes, f.Routes...)
+	}
+	return routes
+}
+
+// Models returns the models migrated by the enabled features
+func (d TemplateData) Models() []string {
+	var models []string
+	for _, f := range enabledFeatures(d.Features) {
+		models = append(models, f.Models...)
+	}
+	return models
+}
+
+// EnvFeatures returns the enabled features adding keys to .env.example
+func (d TemplateData) EnvFeatures() []*Feature {
+	var features []*Feature
+	for _, f := range enabledFeatures(d.Features) {
+		if len(f.Env) > 0 {
+			features = append(features, f)
+		}
+	}
+	return features
+}
+
+// ComposeEnv returns the environment added to the api service of
+// docker-compose.yml by the enabled features
+func (d TemplateData) ComposeEnv() []EnvVar {
+	var env []EnvVar
+	for _, f := range enabledFeatures(d.Features) {
+		env = append(env, f.ComposeEnv...)
+	}
+	return env
+}
+
+// ComposeServices returns the services added to docker-compose.yml by the
+// enabled features
+func (d TemplateData) ComposeServices() []ComposeService {
+	var services []ComposeService
+	for _, f := range enabledFeatures(d.Features) {
+		services = append(services, f.ComposeServices...)
+	}
+	return services
+}
+
+func init() {
+	// Swagger: API documentation, built into the REST templates
+	RegisterFeature(&Feature{
+		Name:        "swagger",
+		Description: "Swagger UI and OpenAPI docs generated by swag",
+		GoRequires: []GoRequire{
+			{"github.com/swaggo/swag", "v1.16.4"},
+		},
+		FrameworkGoRequires: map[string][]GoRequire{
+			"fiber": {{"github.com/swaggo/fiber-swagger", "v1.3.0"}},
+			"gin": {
+				{"github.com/swaggo/files", "v1.0.1"},
+				{"github.com/swaggo/gin-swagger", "v1.6.1"},
+			},
+			"echo":     {{"github.com/swaggo/echo-swagger", "v1.4.1"}},
+			"chi":      {{"github.com/swaggo/http-swagger/v2", "v2.0.2"}},
+			"net/http": {{"github.com/swaggo/http-swagger/v2", "v2.0.2"}},
+		},
+	})
+
+	// Auth: JWT token service and middleware
+	RegisterFeature(&Feature{
+		Name:        "auth",
+		Description: "JWT authentication service and middleware",
+		GoRequires: []GoRequire{
+			{"github.com/golang-jwt/jwt/v5", "v5.3.0"},
+		},
+		FrameworkGoRequires: map[string][]GoRequire{
+			"fiber": {{"github.com/gofiber/contrib/jwt", "v1.1.2"}},
+		},
+		Modules: []FxModule{
+			{Package: "pkg/auth", Comment: "Authentication & authorization"},
+		},
+		EnvComment: []string{
+			"JWT Configuration",
+			"IMPORTANT: Generate a secure random secret for production!",
+			"Example: openssl rand -base64 32",
+		},
+		Env: []EnvVar{
+			{"JWT_SECRET", ""},
+			{"JWT_EXPIRY", "24h"},
+		},
+		ComposeEnv: []EnvVar{
+			{"JWT_SECRET", "dev-secret-change-in-production"},
+			{"JWT_EXPIRY", "24h"},
+		},
+	})
+
+	// Users: registration, login and user CRUD on top of auth
+	RegisterFeature(&Feature{
+		Name:        "users",
+		Description: "User registration, login and management endpoints",
+		Requires:    []string{"auth"},
+		GoRequires: []GoRequire{
+			{"golang.org/x/crypto", "v0.32.0"},
+		},
+		Modules: []FxModule{
+			{Package: "internal/domain/user", Comment: "Domain services"},
+			{Package: "internal/adapters/repository", Comment: "Data persistence"},
+			{Package: "internal/adapters/handlers", Comment: "HTTP handlers"},
+		},
+		Routes: []string{"RegisterUserRoutes"},
+		Models: []string{"User", "RefreshToken"},
+	})
+
+	// Metrics: Prometheus endpoint
+	RegisterFeature(&Feature{
+		Name:        "metrics",
+		Description: "Prometheus metrics endpoint",
+		GoRequires: []GoRequire{
+			{"github.com/prometheus/client_golang", "v1.20.5"},
+		},
+		Routes:     []string{"RegisterMetricsRoutes"},
+		EnvComment: []string{"Metrics Configuration"},
+		Env: []EnvVar{
+			{"METRICS_PATH", "/metrics"},
+		},
+	})
+
+	// Redis: client with connection lifecycle
+	RegisterFeature(&Feature{
+		Name:        "redis",
+		Description: "Redis client managed by fx",
+		GoRequires: []GoRequire{
+			{"github.com/redis/go-redis/v9", "v9.7.0"},
+		},
+		Modules: []FxModule{
+			{Package: "pkg/cache", Comment: "Cache"},
+		},
+		EnvComment: []string{"Redis Configuration"},
+		Env: []EnvVar{
+			{"REDIS_ADDR", "localhost:6379"},
+			{"REDIS_PASSWORD", ""},
+			{"REDIS_DB", "0"},
+		},
+		ComposeEnv: []EnvVar{
+			{"REDIS_ADDR", "redis:6379"},
+		},
+		ComposeServices: []ComposeService{{
+			Name:        "redis",
+			Comment:     "Redis Cache",
+			Image:       "redis:7-alpine",
+			Port:        "6379",
+			Healthcheck: []string{"CMD", "redis-cli", "ping"},
+		}},
+	})
+}
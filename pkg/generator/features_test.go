@@ -0,0 +1,215 @@
+package generator
+
+import (
+	"context"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+// registerTestFeatures registers features for the duration of the test
+func registerTestFeatures(t *testing.T, features ...*Feature) {
+	t.Helper()
+	saved := registeredFeatures
+	t.Cleanup(func() { registeredFeatures = saved })
+	registeredFeatures = slices.Clone(registeredFeatures)
+	for _, f := range features {
+		RegisterFeature(f)
+	}
+}
+
+func TestResolveFeatures(t *testing.T) {
+	registerTestFeatures(t,
+		&Feature{Name: "sessions", Conflicts: []string{"users"}},
+		&Feature{Name: "ping", Requires: []string{"pong"}},
+		&Feature{Name: "pong", Requires: []string{"ping"}},
+		&Feature{Name: "broken", Requires: []string{"missing"}},
+	)
+
+	tests := []struct {
+		name      string
+		template  string
+		requested []string
+		want      []string
+		wantErr   string
+	}{
+		{"minimal builtin", TemplateMinimal, nil, []string{"swagger"}, ""},
+		{"full builtin", TemplateFull, nil, []string{"swagger", "auth", "users"}, ""},
+		{"graphql has none", TemplateGraphQL, nil, nil, ""},
+		{"dependencies come first", TemplateMinimal, []string{"metrics", "users"}, []string{"swagger", "metrics", "auth", "users"}, ""},
+		{"builtin requested again", TemplateFull, []string{"auth", "redis"}, []string{"swagger", "auth", "users", "redis"}, ""},
+		{"unknown feature", TemplateMinimal, []string{"kafka"}, nil, "unknown feature 'kafka'"},
+		{"unsupported template", TemplateGraphQL, []string{"metrics"}, nil, "template 'graphql' does not support optional features"},
+		{"conflict", TemplateMinimal, []string{"users", "sessions"}, nil, "feature 'sessions' conflicts with feature 'users'"},
+		{"conflict with builtin", TemplateFull, []string{"sessions"}, nil, "conflicts with feature 'users'"},
+		{"cycle", TemplateMinimal, []string{"ping"}, nil, "feature dependency cycle: ping -> pong -> ping"},
+		{"unknown dependency", TemplateMinimal, []string{"broken"}, nil, "feature 'broken' requires unknown feature 'missing'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveFeatures(tt.template, tt.requested)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ResolveFeatures() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ResolveFeatures() error = %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ResolveFeatures() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseFeatureList(t *testing.T) {
+	tests := map[string][]string{
+		"":                     nil,
+		"none":                 nil,
+		"auth":                 {"auth"},
+		" auth , users,,auth ": {"auth", "users"},
+	}
+	for input, want := range tests {
+		if got := ParseFeatureList(input); !slices.Equal(got, want) {
+			t.Errorf("ParseFeatureList(%q) = %v, want %v", input, got, want)
+		}
+	}
+}
+
+func TestTemplateDataRequires(t *testing.T) {
+	tests := map[string][]string{
+		"fiber": {
+			"github.com/gofiber/contrib/jwt v1.1.2",
+			"github.com/gofiber/fiber/v2 v2.52.10",
+			"github.com/golang-jwt/jwt/v5 v5.2.0",
+			"github.com/prometheus/client_golang v1.20.5",
+		},
+		"gin": {
+			"github.com/gin-gonic/gin v1.12.0",
+			"github.com/golang-jwt/jwt/v5 v5.2.0",
+			"github.com/prometheus/client_golang v1.20.5",
+		},
+	}
+	for framework, want := range tests {
+		t.Run(framework, func(t *testing.T) {
+			data := TemplateData{Features: []string{"auth", "metrics"}, HTTPFramework: framework}
+			got := data.Requires("github.com/golang-jwt/jwt/v5 v5.2.0")
+			if !slices.Equal(got, want) {
+				t.Errorf("Requires() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+// TestFeaturesLayeredOnMinimal tests that each feature adds its files and wiring to the minimal template
+func TestFeaturesLayeredOnMinimal(t *testing.T) {
+	opts := testProjectOptions("feature-app", TemplateMinimal)
+	opts.Features = []string{"users", "metrics", "redis"}
+
+	files, err := projectFiles("feature-app", opts)
+	if err != nil {
+		t.Fatalf("projectFiles() error = %v", err)
+	}
+	generated := make(map[string]string)
+	for _, file := range files {
+		rel, _ := filepath.Rel("feature-app", file.Path)
+		generated[filepath.ToSlash(rel)] = file.Content
+	}
+
+	checks := map[string][]string{
+		"pkg/auth/jwt.go":                       {"package auth"},
+		"internal/adapters/http/user_routes.go": {"func RegisterUserRoutes("},
+		"internal/adapters/http/metrics.go":     {"func RegisterMetricsRoutes("},
+		"pkg/cache/redis.go":                    {"package cache", `"feature-app/pkg/config"`},
+		"go.mod": {
+			"\tgithub.com/golang-jwt/jwt/v5 v5.3.0\n",
+			"\tgithub.com/prometheus/client_golang v1.20.5\n",
+			"\tgithub.com/redis/go-redis/v9 v9.7.0\n",
+			"\tgolang.org/x/crypto v0.32.0\n",
+		},
+		"cmd/main.go": {
+			"\t\"feature-app/pkg/auth\"\n\t\"feature-app/pkg/cache\"\n\t\"feature-app/pkg/logger\"\n",
+			"\t\tauth.Module,\n",
+			"\t\thandlers.Module,\n",
+			"\t\tcache.Module,\n",
+		},
+		"internal/infrastructure/server/server.go": {
+			"\tfx.Invoke(httpRoutes.RegisterUserRoutes),\n\tfx.Invoke(httpRoutes.RegisterMetricsRoutes),\n)",
+			"ErrorHandler: middleware.ErrorHandler,",
+		},
+		"internal/infrastructure/database/database.go": {"db.AutoMigrate(&models.User{}, &models.RefreshToken{})"},
+		".env.example": {"\n\n# JWT Configuration\n", "JWT_SECRET=\n", "METRICS_PATH=/metrics\n", "REDIS_ADDR=localhost:6379\n"},
+	}
+	for path, wants := range checks {
+		content, ok := generated[path]
+		if !ok {
+			t.Errorf("expected %s to be generated", path)
+			continue
+		}
+		for _, want := range wants {
+			if !strings.Contains(content, want) {
+				t.Errorf("%s should contain %q, got:\n%s", path, want, content)
+			}
+		}
+	}
+}
+
+// TestFeaturesCompose tests that features add their services and environment
+// to docker-compose.yml, and that the api service waits for the services
+func TestFeaturesCompose(t *testing.T) {
+	opts := testProjectOptions("compose-app", TemplateMinimal)
+	opts.Features = []string{"auth", "redis"}
+	opts.Database = "sqlite"
+	sink := NewMemorySink()
+	if err := Generate(context.Background(), opts, sink, nil); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	var compose struct {
+		Services map[string]struct {
+			Image       string            `yaml:"image"`
+			Environment map[string]string `yaml:"environment"`
+			DependsOn   map[string]any    `yaml:"depends_on"`
+		} `yaml:"services"`
+	}
+	if err := yaml.Unmarshal(sink.Files["docker-compose.yml"].Content, &compose); err != nil {
+		t.Fatalf("docker-compose.yml is not valid YAML: %v\n%s", err, sink.Files["docker-compose.yml"].Content)
+	}
+	if compose.Services["redis"].Image != "redis:7-alpine" {
+		t.Errorf("services = %+v, want a redis service", compose.Services)
+	}
+	api := compose.Services["api"]
+	if _, ok := api.DependsOn["redis"]; !ok || len(api.DependsOn) != 1 {
+		t.Errorf("api depends_on = %v, want redis only", api.DependsOn)
+	}
+	for key, want := range map[string]string{"REDIS_ADDR": "redis:6379", "JWT_SECRET": "dev-secret-change-in-production", "JWT_EXPIRY": "24h"} {
+		if api.Environment[key] != want {
+			t.Errorf("api environment %s = %q, want %q", key, api.Environment[key], want)
+		}
+	}
+}
+
+// TestMinimalWithoutFeatures tests that the minimal template is unchanged without features
+func TestMinimalWithoutFeatures(t *testing.T) {
+	files, err := projectFiles("plain-app", testProjectOptions("plain-app", TemplateMinimal))
+	if err != nil {
+		t.Fatalf("projectFiles() error = %v", err)
+	}
+	for _, file := range files {
+		rel, _ := filepath.Rel("plain-app", file.Path)
+		for _, unwanted := range []string{"pkg/auth", "pkg/cache", "user_routes.go", "metrics.go"} {
+			if strings.Contains(filepath.ToSlash(rel), unwanted) {
+				t.Errorf("minimal template without features should not generate %s", rel)
+			}
+		}
+		if strings.Contains(file.Content, "JWT_SECRET") || strings.Contains(file.Content, "fx.Invoke(httpRoutes.Register") {
+			t.Errorf("%s should not reference optional features", rel)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package generator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Import groups, in the order formatGoSource writes them
+const (
+	importGroupStd = iota
+	importGroupThirdParty
+	importGroupLocal
+)
+
+// formatGoSource formats the Go file rendered from the template name with
+// go/format, its imports sorted and grouped like goimports -local modulePath
+// does: the standard library, third-party packages, then the packages of the
+// project. A file that does not parse is an error naming the template and the
+// line, so that a broken template fails the generation rather than the build
+// of the generated project.
+func formatGoSource(name, modulePath, src string) (string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, name, src, parser.ParseComments)
+	if err != nil {
+		var list scanner.ErrorList
+		if errors.As(err, &list) && len(list) > 0 {
+			return "", fmt.Errorf("template %s generates invalid Go code: line %d: %s", name, list[0].Pos.Line, list[0].Msg)
+		}
+		return "", fmt.Errorf("template %s generates invalid Go code: %w", name, err)
+	}
+
+	grouped := groupImports(fset, file, []byte(src), modulePath)
+	formatted, err := format.Source(grouped)
+	if err != nil {
+		return "", fmt.Errorf("failed to format the output of template %s: %w", name, err)
+	}
+	return string(formatted), nil
+}
+
+// isGoFile reports whether the slash-separated path p is a Go source file
+func isGoFile(p string) bool {
+	return strings.HasSuffix(p, ".go")
+}
+
+// importGroup returns the group of the import path p for a project with the
+// module path local
+func importGroup(p, local string) int {
+	switch {
+	case local != "" && (p == local || strings.HasPrefix(p, local+"/")):
+		return importGroupLocal
+	case !strings.Contains(strings.Split(p, "/")[0], "."):
+		return importGroupStd
+	}
+	return importGroupThirdParty
+}
+
+// groupImports rewrites the parenthesized import declarations of file, parsed
+// from src, with their imports sorted and separated into groups. A declaration
+// holding comments that are not attached to an import is left as it is.
+func groupImports(fset *token.FileSet, file *ast.File, src []byte, local string) []byte {
+	type importLine struct {
+		path, text string
+		group      int
+	}
+
+	var decls []*ast.GenDecl
+	for _, decl := range file.Decls {
+		if gd, ok := decl.(*ast.GenDecl); ok && gd.Tok == token.IMPORT && gd.Lparen.IsValid() {
+			decls = append(decls, gd)
+		}
+	}
+	// Rewrite from the end so that the offsets of the earlier declarations hold
+	for _, decl := range slices.Backward(decls) {
+		attached := map[*ast.CommentGroup]bool{}
+		var lines []importLine
+		for _, spec := range decl.Specs {
+			is := spec.(*ast.ImportSpec)
+			p, err := strconv.Unquote(is.Path.Value)
+			if err != nil {
+				return src
+			}
+			var text strings.Builder
+			if is.Doc != nil {
+				attached[is.Doc] = true
+				for _, c := range is.Doc.List {
+					text.WriteString("\t" + c.Text + "\n")
+				}
+			}
+			text.WriteString("\t" + string(src[fset.Position(is.Pos()).Offset:fset.Position(is.Path.End()).Offset]))
+			if is.Comment != nil {
+				attached[is.Comment] = true
+				text.WriteString(" " + string(src[fset.Position(is.Comment.Pos()).Offset:fset.Position(is.Comment.End()).Offset]))
+			}
+			lines = append(lines, importLine{p, text.String(), importGroup(p, local)})
+		}
+		floating := slices.ContainsFunc(file.Comments, func(cg *ast.CommentGroup) bool {
+			return cg.Pos() > decl.Lparen && cg.End() < decl.Rparen && !attached[cg]
+		})
+		if floating {
+			continue
+		}
+
+		slices.SortStableFunc(lines, func(a, b importLine) int {
+			if a.group != b.group {
+				return a.group - b.group
+			}
+			return strings.Compare(a.path, b.path)
+		})
+		var block bytes.Buffer
+		block.WriteString("(\n")
+		for i, line := range lines {
+			if i > 0 && line.group != lines[i-1].group {
+				block.WriteString("\n")
+			}
+			block.WriteString(line.text + "\n")
+		}
+		block.WriteString(")")
+
+		start, end := fset.Position(decl.Lparen).Offset, fset.Position(decl.Rparen).Offset+1
+		src = slices.Concat(src[:start:start], block.Bytes(), src[end:])
+	}
+	return src
+}
@@ -0,0 +1,108 @@
+package generator
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestFormatGoSource(t *testing.T) {
+	src := `package main
+
+import (
+	"example.com/app/internal/server"
+	"go.uber.org/fx"
+	"os"
+	// fmt prints
+	"fmt"
+	log "github.com/sirupsen/logrus" // structured logs
+	"example.com/app"
+)
+
+var (
+	short = 1
+	longerName	= 2
+)
+
+func main() {
+	fmt.Println(os.Args, server.New, fx.New, log.Info, app.Name)
+}
+`
+	want := `package main
+
+import (
+	// fmt prints
+	"fmt"
+	"os"
+
+	log "github.com/sirupsen/logrus" // structured logs
+	"go.uber.org/fx"
+
+	"example.com/app"
+	"example.com/app/internal/server"
+)
+
+var (
+	short      = 1
+	longerName = 2
+)
+
+func main() {
+	fmt.Println(os.Args, server.New, fx.New, log.Info, app.Name)
+}
+`
+	got, err := formatGoSource("main.go.tmpl", "example.com/app", src)
+	if err != nil {
+		t.Fatalf("formatGoSource() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("formatGoSource() =\n%s\nwant:\n%s", got, want)
+	}
+
+	// A module path without a dot is still told apart from the standard library
+	got, err = formatGoSource("main.go.tmpl", "my-app", "package main\n\nimport (\n\t\"my-app/pkg/logger\"\n\t\"os\"\n)\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "import (\n\t\"os\"\n\n\t\"my-app/pkg/logger\"\n)"; !strings.Contains(got, want) {
+		t.Errorf("formatGoSource() =\n%s\nwant imports:\n%s", got, want)
+	}
+}
+
+// TestFormatGoSourceFloatingComment tests that an import block with a comment
+// of its own is only formatted, not regrouped
+func TestFormatGoSourceFloatingComment(t *testing.T) {
+	src := "package main\n\nimport (\n\t\"os\"\n\n\t// Drivers\n\n\t\"fmt\"\n)\n"
+	got, err := formatGoSource("main.go.tmpl", "app", src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != src {
+		t.Errorf("formatGoSource() = %q, want %q", got, src)
+	}
+}
+
+func TestFormatGoSourceParseError(t *testing.T) {
+	src := "package main\n\nfunc main() {\n\tif {\n}\n"
+	_, err := formatGoSource("rest/cmd/main.go.tmpl", "app", src)
+	if err == nil {
+		t.Fatal("formatGoSource() should fail on invalid Go code")
+	}
+	for _, want := range []string{"rest/cmd/main.go.tmpl", "line 4"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q should contain %q", err, want)
+		}
+	}
+}
+
+// TestGenerateInvalidGoTemplate tests that a template generating invalid Go
+// code aborts the generation
+func TestGenerateInvalidGoTemplate(t *testing.T) {
+	opts := testProjectOptions("broken-app", TemplateMinimal)
+	opts.Overrides = fstest.MapFS{"cmd/main.go.tmpl": {Data: []byte("package main\n\nfunc main() {\n")}}
+	err := Generate(context.Background(), opts, NewMemorySink(), nil)
+	if err == nil || !strings.Contains(err.Error(), "cmd/main.go.tmpl") {
+		t.Errorf("Generate() error = %v, want the broken template named", err)
+	}
+}
@@ -0,0 +1,132 @@
+package generator
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+)
+
+// Framework describes an HTTP router a REST or GraphQL project can be generated
+// with (--framework). The templates are written for Fiber; the files bound to
+// another router (server, routes, middleware and handlers) live in the
+// frameworks/<layer> layers, rendered over the template and feature layers.
+// The domain and repository layers are the same for every router.
+type Framework struct {
+	// Name is the value accepted by the --framework flag
+	Name string
+	// Title is the display name used in the generated documentation
+	Title string
+	// Description is the one-line summary shown in the help output
+	Description string
+	// URL is the home page of the router, linked from the generated README
+	URL string
+	// DocsURL is the documentation linked from the generated docs/README.md
+	DocsURL string
+	// GoRequires lists the router modules added to go.mod
+	GoRequires []GoRequire
+	// Layers lists the frameworks/ layers overriding the Fiber files, in order
+	Layers []string
+}
+
+// frameworks lists the supported HTTP routers, default first
+var frameworks = []*Framework{
+	{
+		Name:        "fiber",
+		Title:       "Fiber v2",
+		Description: "Fiber v2, fasthttp based (default)",
+		URL:         "https://gofiber.io/",
+		DocsURL:     "https://docs.gofiber.io/",
+		GoRequires: []GoRequire{
+			{"github.com/gofiber/fiber/v2", "v2.52.10"},
+		},
+	},
+	{
+		Name:        "gin",
+		Title:       "Gin",
+		Description: "Gin, the most widely used Go router",
+		URL:         "https://gin-gonic.com/",
+		DocsURL:     "https://gin-gonic.com/en/docs/",
+		GoRequires: []GoRequire{
+			{"github.com/gin-gonic/gin", "v1.12.0"},
+		},
+		Layers: []string{"gin"},
+	},
+	{
+		Name:        "echo",
+		Title:       "Echo v4",
+		Description: "Echo v4, with centralized error handling",
+		URL:         "https://echo.labstack.com/",
+		DocsURL:     "https://echo.labstack.com/docs",
+		GoRequires: []GoRequire{
+			{"github.com/labstack/echo/v4", "v4.16.0"},
+		},
+		Layers: []string{"echo"},
+	},
+	{
+		Name:        "chi",
+		Title:       "Chi v5",
+		Description: "Chi v5, net/http compatible router",
+		URL:         "https://go-chi.io/",
+		DocsURL:     "https://go-chi.io/#/pages/getting_started",
+		GoRequires: []GoRequire{
+			{"github.com/go-chi/chi/v5", "v5.3.2"},
+		},
+		Layers: []string{"nethttp", "chi"},
+	},
+	{
+		Name:        "net/http",
+		Title:       "net/http",
+		Description: "Standard library http.ServeMux, no router dependency",
+		URL:         "https://pkg.go.dev/net/http",
+		DocsURL:     "https://pkg.go.dev/net/http#ServeMux",
+		Layers:      []string{"nethttp"},
+	},
+}
+
+// Frameworks returns the supported HTTP routers, default first
+func Frameworks() []*Framework {
+	return append([]*Framework(nil), frameworks...)
+}
+
+// lookupFramework returns the supported HTTP router with the given name
+func lookupFramework(name string) (*Framework, bool) {
+	for _, fw := range frameworks {
+		if fw.Name == name {
+			return fw, true
+		}
+	}
+	return nil, false
+}
+
+// frameworkNames returns the names of the supported HTTP routers
+func frameworkNames() []string {
+	names := make([]string, len(frameworks))
+	for i, fw := range frameworks {
+		names[i] = fw.Name
+	}
+	return names
+}
+
+// Framework returns the HTTP router of the project
+func (d TemplateData) Framework() (*Framework, error) {
+	fw, ok := lookupFramework(d.HTTPFramework)
+	if !ok {
+		return nil, fmt.Errorf("unknown framework '%s'", d.HTTPFramework)
+	}
+	return fw, nil
+}
+
+// overlay returns layers followed by the framework layers overriding them:
+// frameworks/<layer>/<l> for every layer l that has one, in the same order
+func (fw *Framework) overlay(layers []string) []string {
+	result := append([]string(nil), layers...)
+	for _, fwLayer := range fw.Layers {
+		for _, l := range layers {
+			layer := path.Join("frameworks", fwLayer, l)
+			if _, err := fs.Stat(templateFS, path.Join(templateRoot, layer)); err == nil {
+				result = append(result, layer)
+			}
+		}
+	}
+	return result
+}
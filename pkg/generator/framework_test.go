@@ -0,0 +1,109 @@
+package generator
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestFrameworks tests that each HTTP router switches the server, routes,
+// middleware and handlers together, leaving the domain layer untouched
+func TestFrameworks(t *testing.T) {
+	tests := []struct {
+		framework string
+		want      map[string][]string
+		unwanted  map[string][]string
+	}{
+		{
+			framework: "fiber",
+			want: map[string][]string{
+				"go.mod": {"\tgithub.com/gofiber/fiber/v2 v2.52.10\n", "\tgithub.com/gofiber/contrib/jwt v1.1.2\n", "\tgithub.com/swaggo/fiber-swagger v1.3.0\n"},
+				"internal/infrastructure/server/server.go": {"ErrorHandler: middleware.ErrorHandler", ") *fiber.App {"},
+				"pkg/auth/middleware.go":                   {"jwtware.New(", "func GetUserID(c *fiber.Ctx)"},
+			},
+		},
+		{
+			framework: "gin",
+			want: map[string][]string{
+				"go.mod": {"\tgithub.com/gin-gonic/gin v1.12.0\n", "\tgithub.com/swaggo/gin-swagger v1.6.1\n"},
+				"internal/infrastructure/server/server.go":      {"func NewServer(log zerolog.Logger) *gin.Engine", "router.Use(middleware.ErrorHandler())"},
+				"internal/adapters/http/routes.go":              {"func RegisterRoutes(router *gin.Engine)"},
+				"pkg/auth/middleware.go":                        {"func NewJWTMiddleware() gin.HandlerFunc", "func GetUserID(c *gin.Context)"},
+				"internal/adapters/middleware/error_handler.go": {"func ErrorHandler() gin.HandlerFunc"},
+			},
+		},
+		{
+			framework: "echo",
+			want: map[string][]string{
+				"go.mod": {"\tgithub.com/labstack/echo/v4 v4.16.0\n", "\tgithub.com/swaggo/echo-swagger v1.4.1\n"},
+				"internal/infrastructure/server/server.go":      {"func NewServer(log zerolog.Logger) *echo.Echo", "e.HTTPErrorHandler = middleware.ErrorHandler"},
+				"pkg/auth/middleware.go":                        {"func NewJWTMiddleware() echo.MiddlewareFunc", "func GetUserID(c echo.Context)"},
+				"internal/adapters/middleware/error_handler.go": {"func ErrorHandler(err error, c echo.Context)"},
+			},
+		},
+		{
+			framework: "chi",
+			want: map[string][]string{
+				"go.mod": {"\tgithub.com/go-chi/chi/v5 v5.3.2\n", "\tgithub.com/swaggo/http-swagger/v2 v2.0.2\n"},
+				"internal/infrastructure/server/server.go":   {"func NewServer(log zerolog.Logger) chi.Router"},
+				"internal/adapters/http/user_routes.go":      {`router.Route("/api/v1"`},
+				"pkg/auth/middleware.go":                     {"func NewJWTMiddleware() func(http.Handler) http.Handler", "func GetUserID(r *http.Request)"},
+				"internal/adapters/handlers/user_handler.go": {`r.PathValue("id")`},
+			},
+		},
+		{
+			framework: "net/http",
+			want: map[string][]string{
+				"go.mod": {"\tgithub.com/swaggo/http-swagger/v2 v2.0.2\n"},
+				"internal/infrastructure/server/server.go":      {"func NewServer(log zerolog.Logger) *http.ServeMux"},
+				"internal/adapters/http/user_routes.go":         {`"POST /api/v1/auth/register"`},
+				"internal/adapters/middleware/error_handler.go": {"func ErrorHandler(h HandlerFunc) http.HandlerFunc"},
+			},
+			unwanted: map[string][]string{
+				"go.mod": {"github.com/go-chi/chi"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.framework, func(t *testing.T) {
+			opts := testProjectOptions("fw-app", TemplateFull)
+			opts.Framework = tt.framework
+			files, err := projectFiles("fw-app", opts)
+			if err != nil {
+				t.Fatalf("projectFiles() error = %v", err)
+			}
+			generated := make(map[string]string)
+			for _, file := range files {
+				rel, _ := filepath.Rel("fw-app", file.Path)
+				generated[filepath.ToSlash(rel)] = file.Content
+			}
+
+			for path, wants := range tt.want {
+				for _, want := range wants {
+					if !strings.Contains(generated[path], want) {
+						t.Errorf("%s should contain %q, got:\n%s", path, want, generated[path])
+					}
+				}
+			}
+			for path, unwanted := range tt.unwanted {
+				for _, s := range unwanted {
+					if strings.Contains(generated[path], s) {
+						t.Errorf("%s should not contain %q", path, s)
+					}
+				}
+			}
+			if tt.framework != DefaultFramework {
+				for path, content := range generated {
+					if strings.Contains(content, "gofiber") {
+						t.Errorf("%s should not reference Fiber", path)
+					}
+				}
+			}
+			// The domain layer does not depend on the router
+			if !strings.Contains(generated["internal/domain/errors.go"], "http.StatusNotFound") {
+				t.Errorf("internal/domain/errors.go should use net/http status codes, got:\n%s", generated["internal/domain/errors.go"])
+			}
+		})
+	}
+}
@@ -0,0 +1,323 @@
+// Package generator renders Go API projects from the go-starter-kit templates.
+//
+// Generate renders a project into any Sink: a directory (DirSink), memory
+// (MemorySink) or an archive stream (TarSink, ZipSink). Create generates a
+// project on disk through a staging directory, so that a failure leaves
+// nothing behind. Upgrade and AddModel work on a generated project. The
+// create-go-starter command is a thin wrapper around this package.
+package generator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+)
+
+// Directory permissions for created folders
+const defaultDirPerm os.FileMode = 0755
+
+// Errors matched with errors.Is against the errors of Create and Generate.
+// The errors keep their own message.
+var (
+	// ErrInvalidOptions reports options rejected by ProjectOptions.Validate
+	ErrInvalidOptions = errors.New("invalid project options")
+	// ErrProjectExists reports that the project directory already exists
+	ErrProjectExists = errors.New("project directory already exists")
+	// ErrWrite reports a failure to write the project to its sink or directory
+	ErrWrite = errors.New("failed to write project")
+)
+
+// kindError is an error that also matches kind with errors.Is
+type kindError struct {
+	err, kind error
+}
+
+func (e *kindError) Error() string   { return e.err.Error() }
+func (e *kindError) Unwrap() []error { return []error{e.err, e.kind} }
+
+// withKind tags err with kind, one of the Err variables; it returns nil for a nil err
+func withKind(kind, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &kindError{err, kind}
+}
+
+// Stage is a step of project generation reported to a Progress callback
+type Stage string
+
+// Generation stages, in the order they run
+const (
+	// StageDirectories creates the template directories
+	StageDirectories Stage = "directories"
+	// StageFiles writes the rendered files
+	StageFiles Stage = "files"
+	// StageStep runs a post-generation step
+	StageStep Stage = "step"
+	// StageHook runs a hook: the pre hooks run before StageDirectories, the
+	// post hooks after StageStep
+	StageHook Stage = "hook"
+)
+
+// Event reports the progress of a generation. Each stage sends an event when
+// it starts, one event per directory or file it writes, and an event once it
+// is done.
+type Event struct {
+	// Stage is the running stage
+	Stage Stage
+	// Path is the directory or file just written, relative to the project root
+	// and slash-separated; it is empty for the start and done events
+	Path string
+	// Done is set on the last event of the stage
+	Done bool
+	// Message is the progress line of a post-generation step or hook, on its
+	// start event
+	Message string
+	// Warning is the error of a hook whose failure is not fatal, on its done event
+	Warning string
+}
+
+// Progress receives the events of a generation. It is called synchronously
+// from the generating goroutine.
+type Progress func(Event)
+
+// Generate renders the project described by opts and writes it to sink: the
+// template directories, the rendered files with the project spec, manifest and
+// base archive, then the files added by the post-generation steps. The pre
+// hooks run first; the post hooks need the project on disk and only run with
+// Create. progress, if not nil, is called as the generation goes. Generate
+// stops at the first error or when ctx is done. It does not close the sink.
+func Generate(ctx context.Context, opts ProjectOptions, sink Sink, progress Progress) error {
+	if err := opts.Validate(); err != nil {
+		return withKind(ErrInvalidOptions, err)
+	}
+	if progress == nil {
+		progress = func(Event) {}
+	}
+	_, err := generate(ctx, opts, sink, progress)
+	return err
+}
+
+// generate implements Generate for validated options and a non-nil progress.
+// It returns the options with the variables set by the pre hooks.
+func generate(ctx context.Context, opts ProjectOptions, sink Sink, progress Progress) (ProjectOptions, error) {
+	opts, err := runHooks(ctx, HookPre, ResolveHooks(opts).Pre, opts, "", progress)
+	if err != nil {
+		return opts, err
+	}
+	tmpl, _ := LookupTemplate(opts.Template)
+
+	progress(Event{Stage: StageDirectories})
+	for _, dir := range tmpl.Directories() {
+		if err := ctx.Err(); err != nil {
+			return opts, err
+		}
+		dir = filepath.ToSlash(dir)
+		if err := sink.Mkdir(dir); err != nil {
+			return opts, withKind(ErrWrite, err)
+		}
+		progress(Event{Stage: StageDirectories, Path: dir})
+	}
+	progress(Event{Stage: StageDirectories, Done: true})
+
+	files, err := projectFiles("", opts)
+	if err != nil {
+		return opts, err
+	}
+	contents := make(map[string]string, len(files))
+	paths := make([]string, 0, len(files))
+	for _, file := range files {
+		p := filepath.ToSlash(file.Path)
+		contents[p] = file.Content
+		paths = append(paths, p)
+	}
+
+	progress(Event{Stage: StageFiles})
+	if err := writeFiles(ctx, sink, paths, contents, StageFiles, progress); err != nil {
+		return opts, err
+	}
+	progress(Event{Stage: StageFiles, Done: true})
+
+	for _, step := range tmpl.PostGenerationSteps() {
+		progress(Event{Stage: StageStep, Message: step.Message})
+		stepFiles := maps.Clone(contents)
+		if err := step.Run(stepFiles); err != nil {
+			return opts, err
+		}
+		var changed []string
+		for p, content := range stepFiles {
+			if old, ok := contents[p]; !ok || old != content {
+				changed = append(changed, p)
+			}
+		}
+		slices.Sort(changed)
+		if err := writeFiles(ctx, sink, changed, stepFiles, StageStep, progress); err != nil {
+			return opts, err
+		}
+		contents = stepFiles
+		progress(Event{Stage: StageStep, Done: true})
+	}
+	return opts, nil
+}
+
+// writeFiles writes the files at paths to sink, reporting each one for stage
+func writeFiles(ctx context.Context, sink Sink, paths []string, contents map[string]string, stage Stage, progress Progress) error {
+	for _, p := range paths {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := sink.WriteFile(p, []byte(contents[p]), fileMode(p)); err != nil {
+			return withKind(ErrWrite, err)
+		}
+		progress(Event{Stage: stage, Path: p})
+	}
+	return nil
+}
+
+// fileMode returns the permissions of the generated file at the slash-separated
+// path p: setup.sh is executable
+func fileMode(p string) os.FileMode {
+	if p == "setup.sh" {
+		return 0755
+	}
+	return 0644
+}
+
+// Create generates the project described by opts in the directory dir, which
+// must not exist. The project is generated in a staging directory next to dir
+// and moved into place once complete, so that a failure or a cancelled ctx
+// leaves nothing behind. The post hooks run in the staging directory, before
+// the move. Create does not initialize a git repository: see InitGitRepo.
+func Create(ctx context.Context, opts ProjectOptions, dir string, progress Progress) error {
+	if err := opts.Validate(); err != nil {
+		return withKind(ErrInvalidOptions, err)
+	}
+	if progress == nil {
+		progress = func(Event) {}
+	}
+
+	// Fail early, before any work, if the project directory is taken
+	if err := checkProjectPathAvailable(dir); err != nil {
+		return err
+	}
+
+	staging, err := newStagingDir(dir)
+	if err != nil {
+		return withKind(ErrWrite, err)
+	}
+	defer staging.cleanup()
+
+	opts, err = generate(ctx, opts, NewDirSink(staging.projectPath), progress)
+	if err != nil {
+		return err
+	}
+	projectPath, err := filepath.Abs(staging.projectPath)
+	if err != nil {
+		return withKind(ErrWrite, err)
+	}
+	if _, err := runHooks(ctx, HookPost, ResolveHooks(opts).Post, opts, projectPath, progress); err != nil {
+		return err
+	}
+
+	// Move the complete project into place with a single rename
+	return staging.commit()
+}
+
+// checkProjectPathAvailable returns an error if the project directory already exists
+func checkProjectPathAvailable(projectPath string) error {
+	if _, err := os.Stat(projectPath); err == nil {
+		return withKind(ErrProjectExists, fmt.Errorf("directory %s already exists. Please choose a different name or remove the existing directory", projectPath))
+	}
+	return nil
+}
+
+// FileGenerator represents a file to be generated
+type FileGenerator struct {
+	Path    string
+	Content string
+}
+
+// projectFiles returns every file generated for a project: the rendered
+// template files followed by the project spec, the manifest and the base
+// archive upgrade merges against.
+func projectFiles(projectPath string, opts ProjectOptions) ([]FileGenerator, error) {
+	return projectFilesAt(projectPath, opts, generationTime())
+}
+
+// projectFilesAt returns the files projectFiles returns, with generatedAt as
+// the generation time recorded in the manifest
+func projectFilesAt(projectPath string, opts ProjectOptions, generatedAt time.Time) ([]FileGenerator, error) {
+	data := opts.TemplateData()
+	files, err := renderedProjectFiles(projectPath, opts, data)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, err := manifestFiles(projectPath, opts, data.Year, generatedAt, files)
+	if err != nil {
+		return nil, err
+	}
+	return append(files, manifest...), nil
+}
+
+// ProjectFiles returns the files generated for opts, with paths relative to the
+// project root, without writing anything. The post-generation steps are not
+// run.
+func ProjectFiles(opts ProjectOptions) ([]FileGenerator, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, withKind(ErrInvalidOptions, err)
+	}
+	return projectFiles("", opts)
+}
+
+// PreviewProjectFiles returns the files ProjectFiles returns for opts, to be
+// compared with the project in projectPath. When that project has a manifest,
+// its generation time is kept, so that the files of an unchanged project are
+// rendered identically.
+func PreviewProjectFiles(projectPath string, opts ProjectOptions) ([]FileGenerator, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, withKind(ErrInvalidOptions, err)
+	}
+	generatedAt := generationTime()
+	if m, err := LoadManifest(projectPath); err == nil {
+		generatedAt = m.GeneratedAt
+	}
+	return projectFilesAt("", opts, generatedAt)
+}
+
+// renderedProjectFiles returns the files rendered for a project with the given
+// template context: the template files, with the overrides applied, followed
+// by the project spec.
+func renderedProjectFiles(projectPath string, opts ProjectOptions, data TemplateData) ([]FileGenerator, error) {
+	files, err := templateFiles(projectPath, opts.Template, data)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Overrides != nil {
+		if files, err = applyOverrides(projectPath, files, opts.Overrides, data); err != nil {
+			return nil, fmt.Errorf("failed to apply overrides: %w", err)
+		}
+	}
+
+	spec, err := projectSpecFile(projectPath, opts)
+	if err != nil {
+		return nil, err
+	}
+	return append(files, spec), nil
+}
+
+// templateFiles looks up the given template in the registry and returns the
+// files to generate, with paths rooted at projectPath.
+func templateFiles(projectPath, template string, data TemplateData) ([]FileGenerator, error) {
+	tmpl, ok := LookupTemplate(template)
+	if !ok {
+		// This case should ideally not be reached if validateTemplate is called beforehand.
+		return nil, fmt.Errorf("unsupported template '%s'", template)
+	}
+	return tmpl.Files(projectPath, data)
+}
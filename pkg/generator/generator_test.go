@@ -1,29 +1,21 @@
-package main
+package generator
 
 import (
-	"github.com/tky0065/go-starter-kit/pkg/utils" // Added for shared validation
+	"context"
+	"errors"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/tky0065/go-starter-kit/pkg/utils" // Added for shared validation
 )
 
 func TestGenerateProjectFiles(t *testing.T) {
 	// Create a temporary directory for testing
-	tempDir := t.TempDir()
 	projectName := "test-project"
-	projectPath := filepath.Join(tempDir, projectName)
-
-	// Create the project structure first (using full template)
-	if err := createProjectStructure(projectPath, TemplateFull); err != nil {
-		t.Fatalf("Failed to create project structure: %v", err)
-	}
-
-	// Generate project files
-	if err := generateProjectFiles(projectPath, projectName, DefaultTemplate); err != nil {
-		t.Fatalf("generateProjectFiles() error = %v", err)
-	}
+	projectPath := writeTestProject(t, testProjectOptions(projectName, DefaultTemplate))
 
 	// Test that go.mod exists and contains project name
 	goModPath := filepath.Join(projectPath, "go.mod")
@@ -84,11 +76,11 @@ func TestGenerateProjectFiles(t *testing.T) {
 	}
 }
 
-func TestGenerateProjectFilesWithInvalidPath(t *testing.T) {
-	// Test with non-existent directory
-	err := generateProjectFiles("/non/existent/path", "test-project", DefaultTemplate)
-	if err == nil {
-		t.Error("generateProjectFiles() should return error for non-existent path")
+func TestCreateWithInvalidPath(t *testing.T) {
+	// Test with a non-existent parent directory
+	err := Create(context.Background(), testProjectOptions("test-project", DefaultTemplate), "/non/existent/path/test-project", nil)
+	if !errors.Is(err, ErrWrite) {
+		t.Errorf("Create() error = %v, want ErrWrite for a non-existent path", err)
 	}
 }
 
@@ -155,51 +147,29 @@ func TestValidateGoModuleName(t *testing.T) {
 	}
 }
 
-// TestGenerateProjectFilesWithInvalidModuleName tests that invalid module names are rejected
-func TestGenerateProjectFilesWithInvalidModuleName(t *testing.T) {
-	tempDir := t.TempDir()
-	projectPath := filepath.Join(tempDir, "test-project")
-
-	// Create the project directory first
-	if err := os.Mkdir(projectPath, 0755); err != nil {
-		t.Fatalf("Failed to create project directory: %v", err)
-	}
-
+// TestGenerateWithInvalidModuleName tests that invalid module names are rejected
+func TestGenerateWithInvalidModuleName(t *testing.T) {
 	// Test with empty module name
-	var err error // Declared err here
-	err = generateProjectFiles(projectPath, "", DefaultTemplate)
-	if err == nil {
-		t.Error("generateProjectFiles() should return error for empty module name")
-	}
-	if !strings.Contains(err.Error(), "empty") {
+	err := Generate(context.Background(), testProjectOptions("", DefaultTemplate), NewMemorySink(), nil)
+	if !errors.Is(err, ErrInvalidOptions) {
+		t.Errorf("Generate() error = %v, want ErrInvalidOptions for empty module name", err)
+	} else if !strings.Contains(err.Error(), "empty") {
 		t.Errorf("Error message should mention 'empty', got: %v", err)
 	}
 
 	// Test with invalid module name
-	err = generateProjectFiles(projectPath, "-invalid", DefaultTemplate)
-	if err == nil {
-		t.Error("generateProjectFiles() should return error for invalid module name")
-	}
-	if !strings.Contains(err.Error(), "invalid module name") {
+	err = Generate(context.Background(), testProjectOptions("-invalid", DefaultTemplate), NewMemorySink(), nil)
+	if !errors.Is(err, ErrInvalidOptions) {
+		t.Errorf("Generate() error = %v, want ErrInvalidOptions for invalid module name", err)
+	} else if !strings.Contains(err.Error(), "invalid module name") {
 		t.Errorf("Error message should mention 'invalid module name', got: %v", err)
 	}
 }
 
 // TestGenerateProjectFilesCreatesAllRequiredFiles tests that all expected files are created
 func TestGenerateProjectFilesCreatesAllRequiredFiles(t *testing.T) {
-	tempDir := t.TempDir()
 	projectName := "complete-test-project"
-	projectPath := filepath.Join(tempDir, projectName)
-
-	// Create the project structure first (using full template)
-	if err := createProjectStructure(projectPath, TemplateFull); err != nil {
-		t.Fatalf("Failed to create project structure: %v", err)
-	}
-
-	// Generate project files
-	if err := generateProjectFiles(projectPath, projectName, DefaultTemplate); err != nil {
-		t.Fatalf("generateProjectFiles() error = %v", err)
-	}
+	projectPath := writeTestProject(t, testProjectOptions(projectName, DefaultTemplate))
 
 	// List of all expected files
 	expectedFiles := []string{
@@ -266,19 +236,8 @@ func TestE2EGeneratedProjectBuilds(t *testing.T) {
 	}
 
 	// Create a temporary directory for testing
-	tempDir := t.TempDir()
 	projectName := "e2e-test-project"
-	projectPath := filepath.Join(tempDir, projectName)
-
-	// Create the complete project structure (using full template)
-	if err := createProjectStructure(projectPath, TemplateFull); err != nil {
-		t.Fatalf("Failed to create project structure: %v", err)
-	}
-
-	// Generate all project files
-	if err := generateProjectFiles(projectPath, projectName, DefaultTemplate); err != nil {
-		t.Fatalf("Failed to generate project files: %v", err)
-	}
+	projectPath := writeTestProject(t, testProjectOptions(projectName, DefaultTemplate))
 
 	// Try to build the generated project
 	t.Run("BuildGeneratedProject", func(t *testing.T) {
@@ -339,18 +298,8 @@ func TestGoModTidyWorkflow(t *testing.T) {
 		t.Skip("skipping E2E test in short mode")
 	}
 
-	tmpDir := t.TempDir()
 	projectName := "test-mod-tidy-workflow"
-	projectPath := filepath.Join(tmpDir, projectName)
-
-	// Create project structure and files (using full template)
-	if err := createProjectStructure(projectPath, TemplateFull); err != nil {
-		t.Fatalf("Failed to create project structure: %v", err)
-	}
-
-	if err := generateProjectFiles(projectPath, projectName, DefaultTemplate); err != nil {
-		t.Fatalf("Failed to generate project files: %v", err)
-	}
+	projectPath := writeTestProject(t, testProjectOptions(projectName, DefaultTemplate))
 
 	// Verify go.mod exists
 	goModPath := filepath.Join(projectPath, "go.mod")
@@ -396,19 +345,8 @@ func TestGoModTidyWorkflow(t *testing.T) {
 
 // TestGenerateGraphQLTemplateFiles tests the GraphQL template generation
 func TestGenerateGraphQLTemplateFiles(t *testing.T) {
-	tempDir := t.TempDir()
 	projectName := "graphql-test-project"
-	projectPath := filepath.Join(tempDir, projectName)
-
-	// Create the project structure first (using graphql template)
-	if err := createProjectStructure(projectPath, TemplateGraphQL); err != nil {
-		t.Fatalf("Failed to create project structure: %v", err)
-	}
-
-	// Generate GraphQL project files
-	if err := generateProjectFiles(projectPath, projectName, TemplateGraphQL); err != nil {
-		t.Fatalf("generateProjectFiles(graphql) error = %v", err)
-	}
+	projectPath := writeTestProject(t, testProjectOptions(projectName, TemplateGraphQL))
 
 	// List of all expected GraphQL template files
 	expectedFiles := []string{
@@ -457,8 +395,8 @@ func TestGenerateGraphQLTemplateFiles(t *testing.T) {
 	if !strings.Contains(string(content), "github.com/99designs/gqlgen") {
 		t.Error("go.mod should contain gqlgen dependency")
 	}
-	if !strings.Contains(string(content), "github.com/gofiber/adaptor") {
-		t.Error("go.mod should contain gofiber/adaptor dependency")
+	if !strings.Contains(string(content), "github.com/gofiber/fiber/v2") {
+		t.Error("go.mod should contain fiber dependency")
 	}
 
 	// Test that gqlgen.yml contains correct configuration
@@ -495,9 +433,20 @@ func TestGenerateGraphQLTemplateFiles(t *testing.T) {
 	}
 }
 
+// generatedDirs returns the directories generated for a project with the
+// given template
+func generatedDirs(t *testing.T, template string) []string {
+	t.Helper()
+	sink := NewMemorySink()
+	if err := Generate(context.Background(), testProjectOptions("dirs-app", template), sink, nil); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	return sink.Dirs
+}
+
 // TestGetDirectoriesForGraphQLTemplate tests that correct directories are created for GraphQL template
 func TestGetDirectoriesForGraphQLTemplate(t *testing.T) {
-	dirs := getDirectoriesForTemplate(TemplateGraphQL)
+	dirs := generatedDirs(t, TemplateGraphQL)
 
 	// Check for GraphQL-specific directories
 	expectedDirs := []string{
@@ -544,19 +493,8 @@ func TestE2EGraphQLProjectBuilds(t *testing.T) {
 	}
 
 	// Create a temporary directory for testing
-	tempDir := t.TempDir()
 	projectName := "e2e-graphql-project"
-	projectPath := filepath.Join(tempDir, projectName)
-
-	// Create the complete project structure (using graphql template)
-	if err := createProjectStructure(projectPath, TemplateGraphQL); err != nil {
-		t.Fatalf("Failed to create project structure: %v", err)
-	}
-
-	// Generate all project files
-	if err := generateProjectFiles(projectPath, projectName, TemplateGraphQL); err != nil {
-		t.Fatalf("Failed to generate project files: %v", err)
-	}
+	projectPath := writeTestProject(t, testProjectOptions(projectName, TemplateGraphQL))
 
 	// Run go mod tidy to verify all dependencies are valid
 	t.Run("GoModTidy", func(t *testing.T) {
@@ -585,3 +523,79 @@ func TestE2EGraphQLProjectBuilds(t *testing.T) {
 		}
 	})
 }
+
+// TestTemplateFilesFromTree verifies that the file list is built by walking the template layers
+func TestTemplateFilesFromTree(t *testing.T) {
+	projectPath := filepath.Join(t.TempDir(), "tree-project")
+
+	tests := []struct {
+		template string
+		want     []string
+		notWant  []string
+	}{
+		{
+			template: TemplateFull,
+			want:     []string{"go.mod", "pkg/auth/jwt.go", "docs/docs.go", "internal/adapters/http/health.go", ".github/workflows/ci.yml"},
+		},
+		{
+			template: TemplateMinimal,
+			want:     []string{"go.mod", "docs/docs.go", "internal/adapters/http/routes.go", ".gitignore"},
+			notWant:  []string{"pkg/auth/jwt.go", "internal/models/user.go"},
+		},
+		{
+			template: TemplateGraphQL,
+			want:     []string{"go.mod", "gqlgen.yml", "graph/schema.graphqls", "Makefile", "Dockerfile"},
+			notWant:  []string{"docs/docs.go", "internal/adapters/http/health.go"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.template, func(t *testing.T) {
+			files, err := templateFiles(projectPath, tt.template, newTemplateData("tree-project"))
+			if err != nil {
+				t.Fatalf("templateFiles() error = %v", err)
+			}
+
+			generated := make(map[string]string)
+			for _, file := range files {
+				rel, err := filepath.Rel(projectPath, file.Path)
+				if err != nil {
+					t.Fatalf("file %s is outside the project: %v", file.Path, err)
+				}
+				if strings.HasSuffix(rel, templateExt) {
+					t.Errorf("file %s should not keep the %s suffix", rel, templateExt)
+				}
+				generated[filepath.ToSlash(rel)] = file.Content
+			}
+
+			for _, path := range tt.want {
+				if _, ok := generated[path]; !ok {
+					t.Errorf("expected %s in %s template files", path, tt.template)
+				}
+			}
+			for _, path := range tt.notWant {
+				if _, ok := generated[path]; ok {
+					t.Errorf("%s template should not generate %s", tt.template, path)
+				}
+			}
+			if !strings.Contains(generated["go.mod"], "module tree-project") {
+				t.Errorf("go.mod should be rendered with the module path, got:\n%s", generated["go.mod"])
+			}
+		})
+	}
+
+	// GraphQL Makefile must come from the graphql layer, overriding the rest layer
+	files, err := templateFiles(projectPath, TemplateGraphQL, newTemplateData("tree-project"))
+	if err != nil {
+		t.Fatalf("templateFiles() error = %v", err)
+	}
+	for _, file := range files {
+		if filepath.Base(file.Path) == "Makefile" && file.Content != NewProjectTemplates("tree-project").GraphQLMakefileTemplate() {
+			t.Error("graphql Makefile should override the shared Makefile")
+		}
+	}
+
+	if _, err := templateFiles(projectPath, "unknown", newTemplateData("tree-project")); err == nil {
+		t.Error("templateFiles() should return an error for an unknown template")
+	}
+}
@@ -0,0 +1,216 @@
+package generator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/mail"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// ErrGitNotFound reports that git is not installed
+var ErrGitNotFound = errors.New("git is not installed")
+
+// ErrGitIdentity reports that git has no user.name and user.email to create
+// the initial commit with, matched with errors.Is
+var ErrGitIdentity = errors.New("git user.name and user.email are not set")
+
+// initialCommitMessage is the message of the initial commit
+const initialCommitMessage = "Initial commit from go-starter-kit"
+
+// gitRemoteName is the name of the remote recorded with GitOptions.Remote
+const gitRemoteName = "origin"
+
+// gitHooks lists the hooks installed with GitOptions.InstallHooks, each
+// rendered from githooks/<name>.tmpl
+var gitHooks = []string{"pre-commit", "pre-push"}
+
+// IsGitAvailable checks if git is installed and available in the system PATH.
+// Returns true if git is available, false otherwise.
+func IsGitAvailable() bool {
+	_, err := exec.LookPath("git")
+	return err == nil
+}
+
+// InitGitRepo initializes a git repository in the given project path,
+// stages all files, and creates an initial commit.
+// It returns ErrGitNotFound if git is not available.
+func InitGitRepo(projectPath string) error {
+	return InitGitRepoWithOptions(projectPath, GitOptions{Init: true})
+}
+
+// InitGitRepoWithOptions initializes a git repository in projectPath like
+// InitGitRepo, on the branch, with the remote, commit author and hooks set by
+// opts. opts.Init is not checked. It returns ErrGitNotFound if git is not
+// available, and an ErrGitIdentity error, before running anything, when
+// neither opts.Author nor the git configuration gives an identity to commit
+// with.
+func InitGitRepoWithOptions(projectPath string, opts GitOptions) error {
+	if !IsGitAvailable() {
+		return ErrGitNotFound
+	}
+
+	env, err := gitAuthorEnv(opts.Author)
+	if err != nil {
+		return err
+	}
+	if opts.Author == "" {
+		if err := checkGitIdentity(projectPath); err != nil {
+			return err
+		}
+	}
+
+	if err := runGit(projectPath, nil, "init"); err != nil {
+		return fmt.Errorf("failed to initialize git repository: %w", err)
+	}
+	// Point HEAD at the branch before the first commit: unlike
+	// git init --initial-branch, this works with any git version
+	if opts.Branch != "" {
+		if err := runGit(projectPath, nil, "symbolic-ref", "HEAD", "refs/heads/"+opts.Branch); err != nil {
+			return fmt.Errorf("failed to set branch %s: %w", opts.Branch, err)
+		}
+	}
+	if opts.Remote != "" {
+		if err := runGit(projectPath, nil, "remote", "add", gitRemoteName, opts.Remote); err != nil {
+			return fmt.Errorf("failed to add remote %s: %w", opts.Remote, err)
+		}
+	}
+
+	if err := runGit(projectPath, nil, "add", "."); err != nil {
+		return fmt.Errorf("failed to stage files: %w", err)
+	}
+	// Use --allow-empty in case there are no files (edge case)
+	if err := runGit(projectPath, env, "commit", "--allow-empty", "-m", initialCommitMessage); err != nil {
+		return fmt.Errorf("failed to create initial commit: %w", err)
+	}
+
+	// Installed after the initial commit, which the hooks would check
+	if opts.InstallHooks {
+		if err := installGitHooks(projectPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runGit runs git with args in dir, with env added to the environment. A
+// failure includes the last line git printed, rather than its whole output.
+func runGit(dir string, env []string, args ...string) error {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	if env != nil {
+		cmd.Env = append(os.Environ(), env...)
+	}
+	output, err := cmd.CombinedOutput()
+	if err == nil {
+		return nil
+	}
+	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+	if last := strings.TrimSpace(lines[len(lines)-1]); last != "" {
+		return fmt.Errorf("%w: %s", err, last)
+	}
+	return err
+}
+
+// checkGitIdentity returns an ErrGitIdentity error if git cannot tell the
+// author or committer of a commit made in dir
+func checkGitIdentity(dir string) error {
+	for _, ident := range []string{"GIT_AUTHOR_IDENT", "GIT_COMMITTER_IDENT"} {
+		cmd := exec.Command("git", "var", ident)
+		cmd.Dir = dir
+		cmd.Stdout = &bytes.Buffer{}
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf(`%w: set them with git config --global user.name "Your Name" and git config --global user.email "you@example.com", or set the commit author`, ErrGitIdentity)
+		}
+	}
+	return nil
+}
+
+// gitAuthorEnv returns the environment setting the author and committer of
+// the initial commit to author ("Name <email>"), or nil if author is empty
+func gitAuthorEnv(author string) ([]string, error) {
+	if author == "" {
+		return nil, nil
+	}
+	addr, err := parseGitAuthor(author)
+	if err != nil {
+		return nil, err
+	}
+	return []string{
+		"GIT_AUTHOR_NAME=" + addr.Name, "GIT_AUTHOR_EMAIL=" + addr.Address,
+		"GIT_COMMITTER_NAME=" + addr.Name, "GIT_COMMITTER_EMAIL=" + addr.Address,
+	}, nil
+}
+
+// parseGitAuthor parses a commit author written "Name <email>"
+func parseGitAuthor(author string) (*mail.Address, error) {
+	addr, err := mail.ParseAddress(author)
+	if err != nil || addr.Name == "" {
+		return nil, fmt.Errorf("invalid git author '%s': use \"Name <email>\"", author)
+	}
+	return addr, nil
+}
+
+// installGitHooks writes the hooks of gitHooks into the .git/hooks directory
+// of the repository in projectPath
+func installGitHooks(projectPath string) error {
+	dir := filepath.Join(projectPath, ".git", "hooks")
+	if err := os.MkdirAll(dir, defaultDirPerm); err != nil {
+		return fmt.Errorf("failed to install git hooks: %w", err)
+	}
+	data := newTemplateData(filepath.Base(projectPath))
+	for _, name := range gitHooks {
+		content, err := renderTemplate("githooks/"+name+templateExt, data)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0755); err != nil {
+			return fmt.Errorf("failed to install git hook %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// validateGitOptions checks the branch, remote and author of opts
+func validateGitOptions(opts GitOptions) error {
+	if opts.Branch != "" {
+		if err := validateBranchName(opts.Branch); err != nil {
+			return err
+		}
+	}
+	if strings.ContainsFunc(opts.Remote, isSpaceOrControl) {
+		return fmt.Errorf("invalid git remote '%s': it cannot contain spaces", opts.Remote)
+	}
+	if opts.Author != "" {
+		if _, err := parseGitAuthor(opts.Author); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateBranchName checks a branch name against the rules of
+// git check-ref-format, without running git
+func validateBranchName(name string) error {
+	invalid := name == "@" ||
+		strings.HasPrefix(name, "-") ||
+		strings.HasPrefix(name, "/") || strings.HasSuffix(name, "/") ||
+		strings.HasSuffix(name, ".") || strings.HasSuffix(name, ".lock") ||
+		strings.Contains(name, "..") || strings.Contains(name, "//") ||
+		strings.Contains(name, "@{") || strings.Contains(name, "/.") ||
+		strings.HasPrefix(name, ".") ||
+		strings.ContainsAny(name, "~^:?*[\\") ||
+		strings.ContainsFunc(name, isSpaceOrControl)
+	if invalid {
+		return fmt.Errorf("invalid git branch name '%s'", name)
+	}
+	return nil
+}
+
+// isSpaceOrControl reports whether r is a space or a control character
+func isSpaceOrControl(r rune) bool {
+	return r <= ' ' || r == 0x7f
+}
@@ -0,0 +1,363 @@
+package generator
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestIsGitAvailable tests the git availability detection function
+func TestIsGitAvailable(t *testing.T) {
+	// This test assumes git is installed on the test machine
+	// If git is not installed, this test will verify the function returns false
+	available := IsGitAvailable()
+
+	// Check if git is actually available using exec.LookPath
+	_, err := exec.LookPath("git")
+	expectedAvailable := err == nil
+
+	if available != expectedAvailable {
+		t.Errorf("IsGitAvailable() = %v, want %v", available, expectedAvailable)
+	}
+}
+
+// TestInitGitRepoSuccess tests successful git repository initialization
+func TestInitGitRepoSuccess(t *testing.T) {
+	// Skip if git is not installed
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed, skipping test")
+	}
+
+	// Create a temporary directory
+	tmpDir := t.TempDir()
+
+	// Create a test file so we have something to commit
+	testFile := filepath.Join(tmpDir, "test.txt")
+	if err := os.WriteFile(testFile, []byte("test content"), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	// Initialize git repo
+	err := InitGitRepo(tmpDir)
+	if err != nil {
+		t.Errorf("InitGitRepo() error = %v, want nil", err)
+	}
+
+	// Verify .git directory exists
+	gitDir := filepath.Join(tmpDir, ".git")
+	if _, err := os.Stat(gitDir); os.IsNotExist(err) {
+		t.Error(".git directory was not created")
+	}
+
+	// Verify initial commit was made
+	cmd := exec.Command("git", "log", "--oneline", "-1")
+	cmd.Dir = tmpDir
+	output, err := cmd.Output()
+	if err != nil {
+		t.Errorf("Failed to get git log: %v", err)
+	}
+
+	if !strings.Contains(string(output), "Initial commit from go-starter-kit") {
+		t.Errorf("Expected initial commit message, got: %s", string(output))
+	}
+}
+
+// TestInitGitRepoWithFiles tests that all files are included in initial commit
+func TestInitGitRepoWithFiles(t *testing.T) {
+	// Skip if git is not installed
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed, skipping test")
+	}
+
+	tmpDir := t.TempDir()
+
+	// Create multiple test files
+	files := []string{"file1.txt", "file2.go", "README.md"}
+	for _, f := range files {
+		filePath := filepath.Join(tmpDir, f)
+		if err := os.WriteFile(filePath, []byte("content for "+f), 0644); err != nil {
+			t.Fatalf("Failed to create test file %s: %v", f, err)
+		}
+	}
+
+	// Create a subdirectory with a file
+	subDir := filepath.Join(tmpDir, "subdir")
+	if err := os.Mkdir(subDir, 0755); err != nil {
+		t.Fatalf("Failed to create subdirectory: %v", err)
+	}
+	subFile := filepath.Join(subDir, "subfile.txt")
+	if err := os.WriteFile(subFile, []byte("subdir content"), 0644); err != nil {
+		t.Fatalf("Failed to create subdir file: %v", err)
+	}
+
+	// Initialize git repo
+	if err := InitGitRepo(tmpDir); err != nil {
+		t.Fatalf("InitGitRepo() error = %v", err)
+	}
+
+	// Verify all files are tracked
+	cmd := exec.Command("git", "ls-files")
+	cmd.Dir = tmpDir
+	output, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("Failed to list git files: %v", err)
+	}
+
+	trackedFiles := string(output)
+	for _, f := range files {
+		if !strings.Contains(trackedFiles, f) {
+			t.Errorf("File %s was not tracked by git", f)
+		}
+	}
+	if !strings.Contains(trackedFiles, "subdir/subfile.txt") {
+		t.Error("Subdirectory file was not tracked by git")
+	}
+}
+
+// TestInitGitRepoInvalidPath tests behavior with an invalid path
+func TestInitGitRepoInvalidPath(t *testing.T) {
+	// Skip if git is not installed
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed, skipping test")
+	}
+
+	err := InitGitRepo("/nonexistent/path/that/does/not/exist")
+	if err == nil {
+		t.Error("Expected error for invalid path, got nil")
+	}
+}
+
+// TestInitGitRepoEmptyDirectory tests git init on empty directory
+func TestInitGitRepoEmptyDirectory(t *testing.T) {
+	// Skip if git is not installed
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed, skipping test")
+	}
+
+	tmpDir := t.TempDir()
+
+	// Initialize git repo on empty directory
+	// This should still work - git allows empty initial commits with --allow-empty
+	// But our implementation stages files, so with no files, git add . is a no-op
+	// The commit should still succeed with the message
+
+	err := InitGitRepo(tmpDir)
+	if err != nil {
+		t.Errorf("InitGitRepo() on empty directory error = %v", err)
+	}
+
+	// Verify .git directory exists
+	gitDir := filepath.Join(tmpDir, ".git")
+	if _, err := os.Stat(gitDir); os.IsNotExist(err) {
+		t.Error(".git directory was not created for empty directory")
+	}
+}
+
+// TestIsGitAvailableReturnsBool tests that isGitAvailable returns a boolean
+func TestIsGitAvailableReturnsBool(t *testing.T) {
+	result := IsGitAvailable()
+	// The function should return either true or false, not panic
+	if result != true && result != false {
+		t.Error("isGitAvailable should return a boolean")
+	}
+}
+
+// TestInitGitRepoCommitMessage tests the exact commit message format
+func TestInitGitRepoCommitMessage(t *testing.T) {
+	// Skip if git is not installed
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed, skipping test")
+	}
+
+	tmpDir := t.TempDir()
+
+	// Create a file to commit
+	testFile := filepath.Join(tmpDir, "README.md")
+	if err := os.WriteFile(testFile, []byte("# Test Project"), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	if err := InitGitRepo(tmpDir); err != nil {
+		t.Fatalf("InitGitRepo() error = %v", err)
+	}
+
+	// Get the exact commit message
+	cmd := exec.Command("git", "log", "--format=%s", "-1")
+	cmd.Dir = tmpDir
+	output, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("Failed to get commit message: %v", err)
+	}
+
+	expectedMessage := "Initial commit from go-starter-kit"
+	actualMessage := strings.TrimSpace(string(output))
+	if actualMessage != expectedMessage {
+		t.Errorf("Commit message = %q, want %q", actualMessage, expectedMessage)
+	}
+}
+
+// gitOutput runs git with args in dir and returns its trimmed output
+func gitOutput(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	output, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("git %s: %v", strings.Join(args, " "), err)
+	}
+	return strings.TrimSpace(string(output))
+}
+
+// TestInitGitRepoWithOptions tests the branch, remote, author and hooks options
+func TestInitGitRepoWithOptions(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed, skipping test")
+	}
+	tmpDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmpDir, "main.go"), []byte("package main\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	opts := GitOptions{
+		Init:         true,
+		Branch:       "trunk",
+		Remote:       "git@github.com:our-org/billing.git",
+		Author:       "Build Bot <bot@example.com>",
+		InstallHooks: true,
+	}
+	if err := InitGitRepoWithOptions(tmpDir, opts); err != nil {
+		t.Fatalf("InitGitRepoWithOptions() error = %v", err)
+	}
+
+	if got := gitOutput(t, tmpDir, "symbolic-ref", "--short", "HEAD"); got != "trunk" {
+		t.Errorf("branch = %q, want trunk", got)
+	}
+	if got := gitOutput(t, tmpDir, "remote", "get-url", "origin"); got != opts.Remote {
+		t.Errorf("origin = %q, want %q", got, opts.Remote)
+	}
+	if got := gitOutput(t, tmpDir, "log", "-1", "--format=%an <%ae>|%cn <%ce>"); got != opts.Author+"|"+opts.Author {
+		t.Errorf("author|committer = %q, want %s", got, opts.Author)
+	}
+	for _, hook := range gitHooks {
+		info, err := os.Stat(filepath.Join(tmpDir, ".git", "hooks", hook))
+		if err != nil {
+			t.Errorf("hook %s not installed: %v", hook, err)
+			continue
+		}
+		if info.Mode()&0111 == 0 {
+			t.Errorf("hook %s should be executable, mode %v", hook, info.Mode())
+		}
+		content, _ := os.ReadFile(filepath.Join(tmpDir, ".git", "hooks", hook))
+		if !strings.Contains(string(content), "command -v golangci-lint") {
+			t.Errorf("hook %s should run golangci-lint when installed", hook)
+		}
+	}
+}
+
+// TestPreCommitHookPathsWithSpaces tests that the pre-commit hook checks a
+// staged file whose path has a space as one file
+func TestPreCommitHookPathsWithSpaces(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed, skipping test")
+	}
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go not installed, skipping test")
+	}
+	tmpDir := t.TempDir()
+	write := func(name, content string) {
+		if err := os.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("go.mod", "module hooktest\n\ngo 1.22\n")
+	write("main.go", "package main\n\nfunc main() {}\n")
+	if err := InitGitRepoWithOptions(tmpDir, GitOptions{Init: true, Author: "Build Bot <bot@example.com>", InstallHooks: true}); err != nil {
+		t.Fatalf("InitGitRepoWithOptions() error = %v", err)
+	}
+	preCommit := func() (string, error) {
+		cmd := exec.Command(filepath.Join(tmpDir, ".git", "hooks", "pre-commit"))
+		cmd.Dir = tmpDir
+		output, err := cmd.CombinedOutput()
+		return string(output), err
+	}
+
+	write("my helper.go", "package main\nfunc  helper() {}\n")
+	gitOutput(t, tmpDir, "add", "my helper.go")
+	if output, err := preCommit(); err == nil || !strings.Contains(output, "\nmy helper.go\n") {
+		t.Errorf("pre-commit should report my helper.go as unformatted, got %v:\n%s", err, output)
+	}
+
+	write("my helper.go", "package main\n\nfunc helper() {}\n")
+	gitOutput(t, tmpDir, "add", "my helper.go")
+	if output, err := preCommit(); err != nil {
+		t.Errorf("pre-commit on a formatted file error = %v:\n%s", err, output)
+	}
+}
+
+// TestInitGitRepoMissingIdentity tests that a missing user.name/email is
+// reported as ErrGitIdentity before anything runs
+func TestInitGitRepoMissingIdentity(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed, skipping test")
+	}
+	// No identity from the environment nor the configuration, and no guess
+	// from the host name
+	for _, name := range []string{"GIT_AUTHOR_NAME", "GIT_AUTHOR_EMAIL", "GIT_COMMITTER_NAME", "GIT_COMMITTER_EMAIL", "EMAIL"} {
+		t.Setenv(name, "")
+		os.Unsetenv(name)
+	}
+	t.Setenv("GIT_CONFIG_GLOBAL", os.DevNull)
+	t.Setenv("GIT_CONFIG_NOSYSTEM", "1")
+	t.Setenv("GIT_CONFIG_COUNT", "1")
+	t.Setenv("GIT_CONFIG_KEY_0", "user.useConfigOnly")
+	t.Setenv("GIT_CONFIG_VALUE_0", "true")
+
+	tmpDir := t.TempDir()
+	err := InitGitRepo(tmpDir)
+	if !errors.Is(err, ErrGitIdentity) {
+		t.Fatalf("InitGitRepo() error = %v, want ErrGitIdentity", err)
+	}
+	if strings.Contains(err.Error(), "***") {
+		t.Errorf("the error should explain the problem, not dump the git output: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, ".git")); !os.IsNotExist(err) {
+		t.Error("no repository should be created without an identity")
+	}
+
+	// An author override does not need the configuration
+	if err := InitGitRepoWithOptions(tmpDir, GitOptions{Init: true, Author: "Build Bot <bot@example.com>"}); err != nil {
+		t.Errorf("InitGitRepoWithOptions() with an author error = %v", err)
+	}
+}
+
+func TestValidateGitOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opts GitOptions
+		want string
+	}{
+		{"defaults", GitOptions{Init: true}, ""},
+		{"all set", GitOptions{Branch: "feature/init", Remote: "https://github.com/org/app.git", Author: "Jane Doe <jane@example.com>"}, ""},
+		{"branch with space", GitOptions{Branch: "my branch"}, "invalid git branch name"},
+		{"branch with dots", GitOptions{Branch: "a..b"}, "invalid git branch name"},
+		{"branch lock", GitOptions{Branch: "main.lock"}, "invalid git branch name"},
+		{"branch option", GitOptions{Branch: "-b"}, "invalid git branch name"},
+		{"remote with space", GitOptions{Remote: "git@host:org/app.git --mirror"}, "invalid git remote"},
+		{"author without email", GitOptions{Author: "Jane Doe"}, "invalid git author"},
+		{"author without name", GitOptions{Author: "jane@example.com"}, "invalid git author"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateGitOptions(tt.opts)
+			if tt.want == "" && err != nil {
+				t.Errorf("validateGitOptions() error = %v", err)
+			}
+			if tt.want != "" && (err == nil || !strings.Contains(err.Error(), tt.want)) {
+				t.Errorf("validateGitOptions() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
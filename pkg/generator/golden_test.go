@@ -0,0 +1,165 @@
+package generator
+
+import (
+	"context"
+	"flag"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// update rewrites the golden files instead of comparing against them:
+// go test ./pkg/generator -run TestGolden -update
+var update = flag.Bool("update", false, "rewrite the golden files in testdata/golden")
+
+// goldenDir holds one directory per combination of goldenCombos
+const goldenDir = "testdata/golden"
+
+// goldenExt is added to every golden file name, so that the generated
+// .gitignore and go.mod files do not apply to the repository
+const goldenExt = ".golden"
+
+// goldenSkipped lists the generated files left out of the golden files: they
+// change with the generator version and the date
+var goldenSkipped = []string{ManifestPath, baseArchivePath}
+
+// goldenCombos lists the projects compared against their golden files, by
+// directory name. Add a combination when adding a template, feature,
+// framework or database.
+var goldenCombos = map[string]func(*ProjectOptions){
+	"minimal": func(o *ProjectOptions) { o.Template = TemplateMinimal },
+	"full":    func(o *ProjectOptions) { o.Template = TemplateFull },
+	"graphql": func(o *ProjectOptions) { o.Template = TemplateGraphQL },
+	"minimal-features": func(o *ProjectOptions) {
+		o.Template = TemplateMinimal
+		o.Features = []string{"auth", "metrics", "redis"}
+	},
+	"minimal-gin":     func(o *ProjectOptions) { o.Template, o.Framework = TemplateMinimal, "gin" },
+	"minimal-echo":    func(o *ProjectOptions) { o.Template, o.Framework = TemplateMinimal, "echo" },
+	"minimal-chi":     func(o *ProjectOptions) { o.Template, o.Framework = TemplateMinimal, "chi" },
+	"minimal-nethttp": func(o *ProjectOptions) { o.Template, o.Framework = TemplateMinimal, "net/http" },
+	"full-gin":        func(o *ProjectOptions) { o.Template, o.Framework = TemplateFull, "gin" },
+	"full-mysql":      func(o *ProjectOptions) { o.Template, o.Database = TemplateFull, "mysql" },
+	"full-sqlite-no-ci": func(o *ProjectOptions) {
+		o.Template, o.Database, o.CI = TemplateFull, "sqlite", "none"
+	},
+}
+
+// TestGolden generates every combination of goldenCombos and compares the
+// files with testdata/golden/<combo>. Run with -update after an intended
+// template change, and review the golden files in the diff.
+func TestGolden(t *testing.T) {
+	for _, name := range slices.Sorted(maps.Keys(goldenCombos)) {
+		t.Run(name, func(t *testing.T) {
+			opts := testProjectOptions("golden-app", TemplateFull)
+			opts.ModulePath = "github.com/acme/golden-app"
+			goldenCombos[name](&opts)
+
+			sink := NewMemorySink()
+			if err := Generate(context.Background(), opts, sink, nil); err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+			got := map[string]string{}
+			for p, f := range sink.Files {
+				if !slices.Contains(goldenSkipped, p) {
+					got[p] = string(f.Content)
+				}
+			}
+
+			dir := filepath.Join(goldenDir, name)
+			if *update {
+				writeGolden(t, dir, got)
+				return
+			}
+			compareGolden(t, dir, got)
+		})
+	}
+
+	// A combination that was removed leaves a directory behind
+	entries, err := os.ReadDir(goldenDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, entry := range entries {
+		if _, ok := goldenCombos[entry.Name()]; ok {
+			continue
+		}
+		if *update {
+			os.RemoveAll(filepath.Join(goldenDir, entry.Name()))
+			continue
+		}
+		t.Errorf("%s has no combination in goldenCombos: remove it or run with -update", filepath.Join(goldenDir, entry.Name()))
+	}
+}
+
+// readGolden returns the golden files in dir, keyed by the slash-separated
+// path of the file they stand for
+func readGolden(t *testing.T, dir string) map[string]string {
+	t.Helper()
+	files := map[string]string{}
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		content, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		files[strings.TrimSuffix(filepath.ToSlash(rel), goldenExt)] = string(content)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to read golden files (generate them with -update): %v", err)
+	}
+	return files
+}
+
+// compareGolden reports every file of got that differs from, is missing from
+// or is not generated anymore in the golden files in dir
+func compareGolden(t *testing.T, dir string, got map[string]string) {
+	t.Helper()
+	want := readGolden(t, dir)
+	for _, p := range slices.Sorted(maps.Keys(got)) {
+		golden, ok := want[p]
+		switch {
+		case !ok:
+			t.Errorf("%s is generated but has no golden file", p)
+		case golden != got[p]:
+			goldenPath := filepath.Join(dir, p+goldenExt)
+			t.Errorf("%s differs from %s:\n%s", p, goldenPath, UnifiedDiff(goldenPath, p, golden, got[p]))
+		}
+	}
+	for _, p := range slices.Sorted(maps.Keys(want)) {
+		if _, ok := got[p]; !ok {
+			t.Errorf("%s has a golden file but is not generated anymore", p)
+		}
+	}
+	if t.Failed() {
+		t.Log("if the change is intended, run: go test ./pkg/generator -run TestGolden -update")
+	}
+}
+
+// writeGolden replaces the golden files in dir with files
+func writeGolden(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	if err := os.RemoveAll(dir); err != nil {
+		t.Fatal(err)
+	}
+	for p, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(p)+goldenExt)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
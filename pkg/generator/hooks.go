@@ -0,0 +1,279 @@
+package generator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// ErrHook reports a failed hook declared fatal, matched with errors.Is
+var ErrHook = errors.New("hook failed")
+
+// HookStage is the point of the generation a hook runs at
+type HookStage string
+
+// Hook stages
+const (
+	// HookPre hooks run before rendering, to validate the options or set
+	// template variables
+	HookPre HookStage = "pre"
+	// HookPost hooks run in the project directory once every file is written
+	HookPost HookStage = "post"
+)
+
+// Values of Hook.OnFailure
+const (
+	// HookFail aborts the generation when the hook fails (the default)
+	HookFail = "fail"
+	// HookWarn reports the failure as a warning and goes on
+	HookWarn = "warn"
+)
+
+// Hooks lists the hooks run around the generation of a project. They are
+// declared in the project spec and in template packs.
+type Hooks struct {
+	// Pre hooks run before rendering, in order
+	Pre []Hook `yaml:"pre,omitempty" json:"pre,omitempty"`
+	// Post hooks run after writing, in order
+	Post []Hook `yaml:"post,omitempty" json:"post,omitempty"`
+}
+
+// Hook is a command or a Go function run before or after the generation. It
+// receives a HookContext as JSON on stdin, or as its argument.
+//
+// A pre hook may print a JSON object on stdout with the template variables it
+// sets: {"variables": {"team": "payments"}}. Anything else it has to say goes
+// to stderr.
+type Hook struct {
+	// Name identifies the hook in the progress output and errors
+	Name string `yaml:"name" json:"name"`
+	// Command is the program to run and its arguments, without a shell. A
+	// relative program path in a template pack is resolved against the pack
+	// directory.
+	Command []string `yaml:"command,omitempty" json:"command,omitempty"`
+	// Go names a function registered with RegisterHook, run instead of a command
+	Go string `yaml:"go,omitempty" json:"go,omitempty"`
+	// OnFailure is HookFail (the default) or HookWarn
+	OnFailure string `yaml:"on_failure,omitempty" json:"on_failure,omitempty"`
+}
+
+// HookContext describes the project to a hook
+type HookContext struct {
+	// Stage is the stage the hook runs at
+	Stage HookStage `json:"stage"`
+	// Hook is the name of the running hook
+	Hook string `json:"hook"`
+	// Project holds the project options, with the variables set by the hooks
+	// that ran before
+	Project ProjectOptions `json:"project"`
+	// ModulePath is the Go module path of the project
+	ModulePath string `json:"module_path"`
+	// Dir is the absolute path of the generated project, for post hooks. It is
+	// the working directory of post hook commands.
+	Dir string `json:"dir,omitempty"`
+	// GeneratorVersion is the version of the generator
+	GeneratorVersion string `json:"generator_version"`
+}
+
+// HookFunc is a hook written in Go. Pre hooks return the template variables
+// they set, or nil; the variables returned by post hooks are ignored.
+type HookFunc func(ctx context.Context, hc HookContext) (map[string]string, error)
+
+// HookTemplate is implemented by templates declaring hooks, such as template packs
+type HookTemplate interface {
+	Template
+	// Hooks returns the hooks of the template, run before those of the project
+	Hooks() Hooks
+}
+
+// registeredHooks holds the Go hooks, by name
+var registeredHooks = map[string]HookFunc{}
+
+// RegisterHook makes a Go hook available to the hook declarations, as
+// go: name. It panics if name is empty or already registered, and is meant
+// to be called from an init function, like RegisterTemplate.
+func RegisterHook(name string, fn HookFunc) {
+	if name == "" {
+		panic("hook name cannot be empty")
+	}
+	if _, ok := registeredHooks[name]; ok {
+		panic(fmt.Sprintf("hook '%s' is already registered", name))
+	}
+	registeredHooks[name] = fn
+}
+
+// ResolveHooks returns the hooks run for a project: those of its template,
+// then those of the options
+func ResolveHooks(opts ProjectOptions) Hooks {
+	var hooks Hooks
+	if tmpl, ok := LookupTemplate(opts.WithDefaults().Template); ok {
+		if ht, ok := tmpl.(HookTemplate); ok {
+			hooks = ht.Hooks()
+		}
+	}
+	return Hooks{
+		Pre:  append(slices.Clone(hooks.Pre), opts.Hooks.Pre...),
+		Post: append(slices.Clone(hooks.Post), opts.Hooks.Post...),
+	}
+}
+
+// validate checks the hook declarations
+func (h Hooks) validate() error {
+	for _, hook := range slices.Concat(h.Pre, h.Post) {
+		if err := hook.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validate checks the hook declaration
+func (h Hook) validate() error {
+	if h.Name == "" {
+		return errors.New("invalid hook: name is required")
+	}
+	switch {
+	case len(h.Command) == 0 && h.Go == "":
+		return fmt.Errorf("invalid hook '%s': set either command or go", h.Name)
+	case len(h.Command) > 0 && h.Go != "":
+		return fmt.Errorf("invalid hook '%s': set either command or go, not both", h.Name)
+	case len(h.Command) > 0 && h.Command[0] == "":
+		return fmt.Errorf("invalid hook '%s': command is empty", h.Name)
+	}
+	if h.Go != "" {
+		if _, ok := registeredHooks[h.Go]; !ok {
+			return fmt.Errorf("invalid hook '%s': no Go hook registered as '%s'", h.Name, h.Go)
+		}
+	}
+	if h.OnFailure != "" {
+		return validateChoice("on_failure of hook '"+h.Name+"'", h.OnFailure, []string{HookFail, HookWarn})
+	}
+	return nil
+}
+
+// withBase returns the hooks with the relative command paths resolved against
+// dir; they are left as they are when dir is empty
+func (h Hooks) withBase(dir string) Hooks {
+	if dir == "" {
+		return Hooks{Pre: slices.Clone(h.Pre), Post: slices.Clone(h.Post)}
+	}
+	resolve := func(hooks []Hook) []Hook {
+		hooks = slices.Clone(hooks)
+		for i, hook := range hooks {
+			if len(hook.Command) > 0 && strings.ContainsRune(hook.Command[0], '/') && !filepath.IsAbs(hook.Command[0]) {
+				hook.Command = slices.Clone(hook.Command)
+				hook.Command[0] = filepath.Join(dir, filepath.FromSlash(hook.Command[0]))
+				hooks[i] = hook
+			}
+		}
+		return hooks
+	}
+	return Hooks{Pre: resolve(h.Pre), Post: resolve(h.Post)}
+}
+
+// hookOutput is the JSON object a pre hook command may print on stdout
+type hookOutput struct {
+	Variables map[string]string `json:"variables"`
+}
+
+// runHooks runs hooks for stage in dir, in order, reporting each one to
+// progress. The variables set by each hook are added to the options the
+// next ones see; runHooks returns the options with every variable set. A
+// failing hook declared with HookWarn is reported on its done event; any
+// other failure stops the run with an ErrHook error.
+func runHooks(ctx context.Context, stage HookStage, hooks []Hook, opts ProjectOptions, dir string, progress Progress) (ProjectOptions, error) {
+	for _, hook := range hooks {
+		if err := ctx.Err(); err != nil {
+			return opts, err
+		}
+		progress(Event{Stage: StageHook, Message: fmt.Sprintf("🪝 Running %s hook %s...", stage, hook.Name)})
+		hc := HookContext{
+			Stage:            stage,
+			Hook:             hook.Name,
+			Project:          opts.WithDefaults(),
+			ModulePath:       opts.Module(),
+			Dir:              dir,
+			GeneratorVersion: Version,
+		}
+		vars, err := runHook(ctx, hook, hc)
+		if err == nil && stage == HookPre {
+			for name := range vars {
+				if err = validateVariableName(name); err != nil {
+					err = fmt.Errorf("hook %s: %w", hook.Name, err)
+					break
+				}
+			}
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return opts, ctx.Err()
+			}
+			if hook.OnFailure != HookWarn {
+				return opts, withKind(ErrHook, err)
+			}
+			progress(Event{Stage: StageHook, Done: true, Warning: err.Error()})
+			continue
+		}
+		if stage == HookPre && len(vars) > 0 {
+			opts.Variables = maps.Clone(opts.Variables)
+			if opts.Variables == nil {
+				opts.Variables = map[string]string{}
+			}
+			maps.Copy(opts.Variables, vars)
+		}
+		progress(Event{Stage: StageHook, Done: true})
+	}
+	return opts, nil
+}
+
+// runHook runs a single hook with hc and returns the variables it sets
+func runHook(ctx context.Context, hook Hook, hc HookContext) (map[string]string, error) {
+	if hook.Go != "" {
+		fn, ok := registeredHooks[hook.Go]
+		if !ok {
+			return nil, fmt.Errorf("hook %s: no Go hook registered as '%s'", hook.Name, hook.Go)
+		}
+		vars, err := fn(ctx, hc)
+		if err != nil {
+			return nil, fmt.Errorf("hook %s failed: %w", hook.Name, err)
+		}
+		return vars, nil
+	}
+
+	input, err := json.Marshal(hc)
+	if err != nil {
+		return nil, err
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, hook.Command[0], hook.Command[1:]...)
+	cmd.Dir = hc.Dir
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		output := strings.TrimSpace(stderr.String())
+		if output == "" {
+			output = strings.TrimSpace(stdout.String())
+		}
+		if output != "" {
+			return nil, fmt.Errorf("hook %s failed: %w\n%s", hook.Name, err, output)
+		}
+		return nil, fmt.Errorf("hook %s failed: %w", hook.Name, err)
+	}
+
+	if hc.Stage != HookPre || len(bytes.TrimSpace(stdout.Bytes())) == 0 {
+		return nil, nil
+	}
+	var out hookOutput
+	if err := json.Unmarshal(stdout.Bytes(), &out); err != nil {
+		return nil, fmt.Errorf("hook %s printed invalid JSON on stdout: %w", hook.Name, err)
+	}
+	return out.Variables, nil
+}
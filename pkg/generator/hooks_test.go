@@ -0,0 +1,210 @@
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+// registerTestHook registers a Go hook for the duration of the test
+func registerTestHook(t *testing.T, name string, fn HookFunc) {
+	t.Helper()
+	t.Cleanup(func() { delete(registeredHooks, name) })
+	RegisterHook(name, fn)
+}
+
+// writeHookScript writes an executable shell script in dir and returns its path
+func writeHookScript(t *testing.T, dir, name, script string) string {
+	t.Helper()
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh is not available")
+	}
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte("#!/bin/sh\n"+script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestHooksValidate(t *testing.T) {
+	registerTestHook(t, "noop", func(context.Context, HookContext) (map[string]string, error) { return nil, nil })
+
+	tests := []struct {
+		name string
+		hook Hook
+		want string
+	}{
+		{"command", Hook{Name: "gen", Command: []string{"buf", "generate"}}, ""},
+		{"go", Hook{Name: "gen", Go: "noop", OnFailure: HookWarn}, ""},
+		{"no name", Hook{Command: []string{"true"}}, "name is required"},
+		{"nothing to run", Hook{Name: "gen"}, "set either command or go"},
+		{"both", Hook{Name: "gen", Command: []string{"true"}, Go: "noop"}, "not both"},
+		{"empty command", Hook{Name: "gen", Command: []string{""}}, "command is empty"},
+		{"unregistered", Hook{Name: "gen", Go: "missing"}, "no Go hook registered"},
+		{"on_failure", Hook{Name: "gen", Command: []string{"true"}, OnFailure: "ignore"}, "invalid on_failure"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := testProjectOptions("hook-app", TemplateMinimal)
+			opts.Hooks.Post = []Hook{tt.hook}
+			err := opts.Validate()
+			if tt.want == "" && err != nil {
+				t.Errorf("Validate() error = %v", err)
+			}
+			if tt.want != "" && (err == nil || !strings.Contains(err.Error(), tt.want)) {
+				t.Errorf("Validate() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+// TestCreateRunsHooks tests that pre hooks set variables and that post hooks
+// run in the project directory with the context on stdin
+func TestCreateRunsHooks(t *testing.T) {
+	scripts := t.TempDir()
+	pre := writeHookScript(t, scripts, "pre.sh", `cat > /dev/null
+echo '{"variables": {"team": "payments"}}'
+`)
+	post := writeHookScript(t, scripts, "post.sh", "cat > hook-context.json\n")
+
+	dir := filepath.Join(t.TempDir(), "hook-app")
+	opts := testProjectOptions("hook-app", TemplateMinimal)
+	opts.Overrides = fstest.MapFS{"TEAM.tmpl": {Data: []byte("{{.Vars.team}}\n")}}
+	opts.Hooks = Hooks{
+		Pre:  []Hook{{Name: "enrich", Command: []string{pre}}},
+		Post: []Hook{{Name: "register", Command: []string{post}}},
+	}
+	var events []Event
+	if err := Create(context.Background(), opts, dir, func(e Event) { events = append(events, e) }); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	if content, _ := os.ReadFile(filepath.Join(dir, "TEAM")); string(content) != "payments\n" {
+		t.Errorf("TEAM = %q, want the variable set by the pre hook", content)
+	}
+	content, err := os.ReadFile(filepath.Join(dir, "hook-context.json"))
+	if err != nil {
+		t.Fatalf("the post hook should run in the project directory: %v", err)
+	}
+	var hc HookContext
+	if err := json.Unmarshal(content, &hc); err != nil {
+		t.Fatal(err)
+	}
+	if hc.Stage != HookPost || hc.Hook != "register" || hc.ModulePath != "hook-app" || hc.Project.Variables["team"] != "payments" {
+		t.Errorf("hook context = %+v", hc)
+	}
+	if events[0].Stage != StageHook || events[len(events)-1].Stage != StageHook {
+		t.Errorf("pre hooks should run first and post hooks last, got %v then %v", events[0], events[len(events)-1])
+	}
+}
+
+func TestHookFailure(t *testing.T) {
+	script := writeHookScript(t, t.TempDir(), "fail.sh", "echo 'team is required' >&2\nexit 3\n")
+
+	dir := filepath.Join(t.TempDir(), "hook-app")
+	opts := testProjectOptions("hook-app", TemplateMinimal)
+	opts.Hooks.Post = []Hook{{Name: "check", Command: []string{script}}}
+	err := Create(context.Background(), opts, dir, nil)
+	if !errors.Is(err, ErrHook) || !strings.Contains(err.Error(), "team is required") {
+		t.Errorf("Create() error = %v, want ErrHook with the hook output", err)
+	}
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Error("a failing post hook should leave nothing behind")
+	}
+
+	opts.Hooks.Post[0].OnFailure = HookWarn
+	var warnings []string
+	progress := func(e Event) {
+		if e.Warning != "" {
+			warnings = append(warnings, e.Warning)
+		}
+	}
+	if err := Create(context.Background(), opts, dir, progress); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "team is required") {
+		t.Errorf("warnings = %v", warnings)
+	}
+}
+
+func TestGoHook(t *testing.T) {
+	registerTestHook(t, "test-enrich", func(ctx context.Context, hc HookContext) (map[string]string, error) {
+		if hc.Stage != HookPre || hc.Project.ProjectName != "go-hook-app" {
+			return nil, errors.New("unexpected context")
+		}
+		return map[string]string{"team": "platform"}, nil
+	})
+	registerTestHook(t, "test-invalid", func(context.Context, HookContext) (map[string]string, error) {
+		return map[string]string{"base-image": "x"}, nil
+	})
+
+	opts := testProjectOptions("go-hook-app", TemplateMinimal)
+	opts.Overrides = fstest.MapFS{"TEAM.tmpl": {Data: []byte("{{.Vars.team}}\n")}}
+	opts.Hooks.Pre = []Hook{{Name: "enrich", Go: "test-enrich"}}
+	sink := NewMemorySink()
+	if err := Generate(context.Background(), opts, sink, nil); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if got := string(sink.Files["TEAM"].Content); got != "platform\n" {
+		t.Errorf("TEAM = %q", got)
+	}
+
+	opts.Hooks.Pre = []Hook{{Name: "invalid", Go: "test-invalid"}}
+	if err := Generate(context.Background(), opts, NewMemorySink(), nil); !errors.Is(err, ErrHook) {
+		t.Errorf("Generate() error = %v, want ErrHook for an invalid variable", err)
+	}
+}
+
+// TestTemplatePackHooks tests that the hooks of a pack run before those of the
+// project, with their commands relative to the pack directory
+func TestTemplatePackHooks(t *testing.T) {
+	packDir := t.TempDir()
+	writeHookScript(t, packDir, "hook.sh", "cat > /dev/null\necho \"$1\" >> \"$HOOK_LOG\"\n")
+	files := map[string]string{
+		TemplatePackManifest: "name: hook-pack\nhooks:\n  pre:\n    - name: pack\n      command: [./hook.sh, pack]\n",
+		"files/go.mod.tmpl":  "module {{.ModulePath}}\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(packDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tmpl, err := LoadTemplateDir(packDir)
+	if err != nil {
+		t.Fatalf("LoadTemplateDir() error = %v", err)
+	}
+	registerTestTemplate(t, tmpl)
+
+	log := filepath.Join(t.TempDir(), "hooks.log")
+	t.Setenv("HOOK_LOG", log)
+	opts := testProjectOptions("pack-hook-app", "hook-pack")
+	opts.Hooks.Pre = []Hook{{Name: "project", Command: []string{filepath.Join(packDir, "hook.sh"), "project"}}}
+
+	if err := Generate(context.Background(), opts, NewMemorySink(), nil); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	content, err := os.ReadFile(log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "pack\nproject\n" {
+		t.Errorf("hooks ran in order %q, want the pack hook first", content)
+	}
+
+	if _, err := LoadTemplatePack(fstest.MapFS{
+		TemplatePackManifest: {Data: []byte("name: x\nhooks:\n  post:\n    - name: gen\n")},
+		"files/go.mod":       {Data: []byte("module x\n")},
+	}); err == nil || !strings.Contains(err.Error(), "set either command or go") {
+		t.Errorf("LoadTemplatePack() error = %v, want the invalid hook reported", err)
+	}
+}
@@ -0,0 +1,245 @@
+package generator
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ManifestPath is where the generation manifest is written, relative to the
+// project root
+const ManifestPath = ".go-starter.json"
+
+// baseArchivePath is where the generated files are archived as written,
+// relative to the project root. upgrade merges the user's changes against it.
+const baseArchivePath = ".go-starter/base.tar.gz"
+
+// ErrNoManifest reports that a project has no manifest, matched with
+// errors.Is: it was not generated by create-go-starter, or by a version
+// without manifests
+var ErrNoManifest = errors.New("no " + ManifestPath + " found")
+
+// Manifest records how a project was generated: the generator and templates
+// versions, the template, module path and features, the options and the hash
+// of every generated file. upgrade compares the hashes with the files on disk
+// to tell untouched files from edited ones; add-model and doctor read the
+// layout of the project from it.
+type Manifest struct {
+	// GeneratorVersion is the create-go-starter version that wrote the files
+	GeneratorVersion string `json:"generator_version"`
+	// TemplatesVersion is the digest of the templates the files were rendered from
+	TemplatesVersion string `json:"templates_version"`
+	// GeneratedAt is when the files were rendered, rewritten by upgrade
+	GeneratedAt time.Time `json:"generated_at"`
+	// Template is the name of the project template
+	Template string `json:"template"`
+	// ModulePath is the Go module path of the project
+	ModulePath string `json:"module_path"`
+	// Features lists the enabled features, including the ones built into the
+	// template and the ones enabled as requirements
+	Features []string `json:"features"`
+	// Year is the generation year, kept so that upgrades leave the license alone
+	Year int `json:"year"`
+	// Options are the resolved project options
+	Options ProjectOptions `json:"options"`
+	// Files maps each generated path, relative to the project root, to the
+	// SHA-256 of its content as generated
+	Files map[string]string `json:"files"`
+}
+
+// newManifest returns the manifest of the files rendered for opts in projectPath
+// at generatedAt
+func newManifest(projectPath string, opts ProjectOptions, year int, generatedAt time.Time, files []FileGenerator) (*Manifest, error) {
+	opts = opts.WithDefaults()
+	features, err := ResolveFeatures(opts.Template, opts.Features)
+	if err != nil {
+		return nil, err
+	}
+	if features == nil {
+		features = []string{}
+	}
+	m := &Manifest{
+		GeneratorVersion: Version,
+		TemplatesVersion: TemplatesVersion(),
+		GeneratedAt:      generatedAt,
+		Template:         opts.Template,
+		ModulePath:       opts.Module(),
+		Features:         features,
+		Year:             year,
+		Options:          opts,
+		Files:            make(map[string]string, len(files)),
+	}
+	for _, file := range files {
+		rel, err := projectRelPath(projectPath, file.Path)
+		if err != nil {
+			return nil, err
+		}
+		m.Files[rel] = hashContent([]byte(file.Content))
+	}
+	return m, nil
+}
+
+// generationTime returns the time recorded in the manifest: SOURCE_DATE_EPOCH
+// when set, so that reproducible builds get identical manifests, or the
+// current time
+func generationTime() time.Time {
+	if epoch, err := strconv.ParseInt(os.Getenv("SOURCE_DATE_EPOCH"), 10, 64); err == nil {
+		return time.Unix(epoch, 0).UTC()
+	}
+	return time.Now().UTC().Truncate(time.Second)
+}
+
+// manifestFiles returns the manifest and the base archive of the files rendered
+// at generatedAt as files to write in the project
+func manifestFiles(projectPath string, opts ProjectOptions, year int, generatedAt time.Time, files []FileGenerator) ([]FileGenerator, error) {
+	m, err := newManifest(projectPath, opts, year, generatedAt, files)
+	if err != nil {
+		return nil, err
+	}
+	content, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode manifest: %w", err)
+	}
+	base, err := baseArchive(projectPath, files)
+	if err != nil {
+		return nil, err
+	}
+	return []FileGenerator{
+		{Path: filepath.Join(projectPath, ManifestPath), Content: string(content) + "\n"},
+		{Path: filepath.Join(projectPath, filepath.FromSlash(baseArchivePath)), Content: string(base)},
+	}, nil
+}
+
+// LoadManifest reads the manifest of the project in projectPath. It returns an
+// ErrNoManifest error if the project has none.
+func LoadManifest(projectPath string) (*Manifest, error) {
+	content, err := os.ReadFile(filepath.Join(projectPath, ManifestPath))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w in %s: the project was not generated by create-go-starter, or by a version without manifests", ErrNoManifest, projectPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var m Manifest
+	if err := json.Unmarshal(content, &m); err != nil {
+		return nil, fmt.Errorf("invalid manifest %s: %w", ManifestPath, err)
+	}
+	if m.Files == nil {
+		m.Files = map[string]string{}
+	}
+	// Manifests written before these fields existed only have the options
+	if m.Template == "" {
+		m.Template = m.Options.WithDefaults().Template
+	}
+	if m.ModulePath == "" {
+		m.ModulePath = m.Options.Module()
+	}
+	if m.Features == nil {
+		m.Features, _ = ResolveFeatures(m.Template, m.Options.Features)
+	}
+	return &m, nil
+}
+
+// hashContent returns the hex-encoded SHA-256 of content
+func hashContent(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// projectRelPath returns path relative to projectPath, with forward slashes
+func projectRelPath(projectPath, path string) (string, error) {
+	rel, err := filepath.Rel(projectPath, path)
+	if err != nil {
+		return "", err
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+// baseArchive returns a gzipped tarball of the rendered files. Entries are
+// sorted and carry no timestamps, so rendering the same files always gives
+// the same archive.
+func baseArchive(projectPath string, files []FileGenerator) ([]byte, error) {
+	contents := make(map[string]string, len(files))
+	for _, file := range files {
+		rel, err := projectRelPath(projectPath, file.Path)
+		if err != nil {
+			return nil, err
+		}
+		contents[rel] = file.Content
+	}
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, name := range slices.Sorted(maps.Keys(contents)) {
+		header := &tar.Header{
+			Name:    name,
+			Mode:    0644,
+			Size:    int64(len(contents[name])),
+			ModTime: time.Unix(0, 0),
+			Format:  tar.FormatPAX,
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return nil, fmt.Errorf("failed to archive %s: %w", name, err)
+		}
+		if _, err := io.WriteString(tw, contents[name]); err != nil {
+			return nil, fmt.Errorf("failed to archive %s: %w", name, err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// readBaseArchive returns the files archived in the project in projectPath,
+// keyed by their path relative to the project root. A missing archive gives
+// no files: every edited file then merges without a common base.
+func readBaseArchive(projectPath string) (map[string]string, error) {
+	files := map[string]string{}
+	f, err := os.Open(filepath.Join(projectPath, filepath.FromSlash(baseArchivePath)))
+	if errors.Is(err, fs.ErrNotExist) {
+		return files, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base archive %s: %w", baseArchivePath, err)
+	}
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return files, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid base archive %s: %w", baseArchivePath, err)
+		}
+		var content strings.Builder
+		if _, err := io.Copy(&content, tr); err != nil {
+			return nil, fmt.Errorf("invalid base archive %s: %w", baseArchivePath, err)
+		}
+		files[header.Name] = content.String()
+	}
+}
@@ -0,0 +1,119 @@
+package generator
+
+import (
+	"slices"
+	"strings"
+)
+
+// Conflict markers written by merge3, as git does
+const (
+	conflictOurs   = "<<<<<<< yours\n"
+	conflictSep    = "=======\n"
+	conflictTheirs = ">>>>>>> template\n"
+)
+
+// lineBlock is a run of n lines equal in two texts, starting at line a of the
+// first one and line b of the second one
+type lineBlock struct {
+	a, b, n int
+}
+
+// matchingBlocks returns the runs of lines a and b have in common, in order
+func matchingBlocks(a, b []string) []lineBlock {
+	var blocks []lineBlock
+	i, j := 0, 0
+	for _, line := range diffLines(a, b) {
+		switch line.op {
+		case diffEqual:
+			if n := len(blocks); n > 0 && blocks[n-1].a+blocks[n-1].n == i && blocks[n-1].b+blocks[n-1].n == j {
+				blocks[n-1].n++
+			} else {
+				blocks = append(blocks, lineBlock{i, j, 1})
+			}
+			i++
+			j++
+		case diffDelete:
+			i++
+		case diffInsert:
+			j++
+		}
+	}
+	return blocks
+}
+
+// syncRegion is a run of base lines left unchanged by both sides of a merge:
+// base[base:baseEnd] is found at line ours of ours and line theirs of theirs
+type syncRegion struct {
+	base, baseEnd, ours, theirs int
+}
+
+// syncRegions returns the regions of base unchanged in both ours and theirs,
+// followed by an empty region at the end of the three texts
+func syncRegions(base, ours, theirs []string) []syncRegion {
+	oursBlocks, theirsBlocks := matchingBlocks(base, ours), matchingBlocks(base, theirs)
+
+	var regions []syncRegion
+	i, j := 0, 0
+	for i < len(oursBlocks) && j < len(theirsBlocks) {
+		o, t := oursBlocks[i], theirsBlocks[j]
+		start, end := max(o.a, t.a), min(o.a+o.n, t.a+t.n)
+		if start < end {
+			regions = append(regions, syncRegion{start, end, o.b + start - o.a, t.b + start - t.a})
+		}
+		if o.a+o.n < t.a+t.n {
+			i++
+		} else {
+			j++
+		}
+	}
+	return append(regions, syncRegion{len(base), len(base), len(ours), len(theirs)})
+}
+
+// merge3 merges the changes made from base to ours and from base to theirs,
+// line by line. Where both sides changed the same lines differently, the
+// result holds both versions between git-style conflict markers. It returns
+// the merged text and the number of conflicts.
+func merge3(base, ours, theirs string) (string, int) {
+	baseLines, oursLines, theirsLines := splitLines(base), splitLines(ours), splitLines(theirs)
+
+	var out strings.Builder
+	conflicts := 0
+	b, o, t := 0, 0, 0
+	for _, r := range syncRegions(baseLines, oursLines, theirsLines) {
+		baseChunk, oursChunk, theirsChunk := baseLines[b:r.base], oursLines[o:r.ours], theirsLines[t:r.theirs]
+		switch {
+		case slices.Equal(oursChunk, theirsChunk), slices.Equal(theirsChunk, baseChunk):
+			writeLines(&out, oursChunk)
+		case slices.Equal(oursChunk, baseChunk):
+			writeLines(&out, theirsChunk)
+		default:
+			conflicts++
+			out.WriteString(conflictOurs)
+			writeLines(&out, terminated(oursChunk))
+			out.WriteString(conflictSep)
+			writeLines(&out, terminated(theirsChunk))
+			out.WriteString(conflictTheirs)
+		}
+
+		writeLines(&out, baseLines[r.base:r.baseEnd])
+		n := r.baseEnd - r.base
+		b, o, t = r.baseEnd, r.ours+n, r.theirs+n
+	}
+	return out.String(), conflicts
+}
+
+// writeLines writes lines as they are, each keeping its newline
+func writeLines(out *strings.Builder, lines []string) {
+	for _, line := range lines {
+		out.WriteString(line)
+	}
+}
+
+// terminated returns lines with a newline added to the last one if it lacks
+// it, so that a conflict marker following the lines starts a new line
+func terminated(lines []string) []string {
+	if n := len(lines); n > 0 && !strings.HasSuffix(lines[n-1], "\n") {
+		lines = append(slices.Clip(lines[:n-1]), lines[n-1]+"\n")
+	}
+	return lines
+}
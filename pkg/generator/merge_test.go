@@ -0,0 +1,42 @@
+package generator
+
+import "testing"
+
+func TestMerge3(t *testing.T) {
+	base := "a\nb\nc\nd\ne\n"
+	tests := []struct {
+		name, ours, theirs, want string
+		conflicts                int
+	}{
+		{"unchanged", base, base, base, 0},
+		{"ours only", "a\nB\nc\nd\ne\n", base, "a\nB\nc\nd\ne\n", 0},
+		{"theirs only", base, "a\nb\nc\nd\ne\nf\n", "a\nb\nc\nd\ne\nf\n", 0},
+		{"both sides apart", "A\nb\nc\nd\ne\n", "a\nb\nc\nd\nE\n", "A\nb\nc\nd\nE\n", 0},
+		{"same change", "a\nB\nc\nd\ne\n", "a\nB\nc\nd\ne\n", "a\nB\nc\nd\ne\n", 0},
+		{"deletion and insertion", "a\nc\nd\ne\n", "a\nb\nc\nd\nx\ne\n", "a\nc\nd\nx\ne\n", 0},
+		{
+			"conflict", "a\nmine\nc\nd\ne\n", "a\ntheirs\nc\nd\ne\n",
+			"a\n<<<<<<< yours\nmine\n=======\ntheirs\n>>>>>>> template\nc\nd\ne\n", 1,
+		},
+		{
+			"conflict without final newline", "a\nb\nc\nd\nmine", "a\nb\nc\nd\ntheirs",
+			"a\nb\nc\nd\n<<<<<<< yours\nmine\n=======\ntheirs\n>>>>>>> template\n", 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, conflicts := merge3(base, tt.ours, tt.theirs)
+			if got != tt.want || conflicts != tt.conflicts {
+				t.Errorf("merge3() = %q, %d conflicts; want %q, %d conflicts", got, conflicts, tt.want, tt.conflicts)
+			}
+		})
+	}
+}
+
+func TestMerge3WithoutBase(t *testing.T) {
+	got, conflicts := merge3("", "x\n", "y\n")
+	if conflicts != 1 || got != "<<<<<<< yours\nx\n=======\ny\n>>>>>>> template\n" {
+		t.Errorf("merge3() without base = %q, %d conflicts", got, conflicts)
+	}
+}
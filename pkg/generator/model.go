@@ -0,0 +1,483 @@
+package generator
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// Model describes a CRUD resource scaffolded into a project by add-model.
+// The naming variants are derived once from the name given on the command
+// line so that templates never have to transform identifiers.
+type Model struct {
+	// Name is the Go type name (e.g. BlogPost)
+	Name string
+	// Plural is the plural Go name, used in handler method names (e.g. BlogPosts)
+	Plural string
+	// Package is the domain package name (e.g. blogpost)
+	Package string
+	// File is the snake_case base name of the generated files (e.g. blog_post)
+	File string
+	// Path is the URL segment of the resource under /api/v1 (e.g. blog-posts)
+	Path string
+	// Label is the human readable name used in comments and messages (e.g. blog post)
+	Label string
+	// Code is the prefix of the error codes returned by the API (e.g. BLOG_POST)
+	Code string
+	// Fields lists the fields of the model, in declaration order, including
+	// the foreign keys of its belongs_to relations
+	Fields []ModelField
+	// Relations lists the associations of the model with other models
+	Relations []ModelRelation
+}
+
+// ModelField is a field of a generated model
+type ModelField struct {
+	// Name is the Go field name (e.g. PublishedAt)
+	Name string
+	// JSON is the snake_case name used in JSON and as the column name (e.g. published_at)
+	JSON string
+	// Type is the field type given on the command line (e.g. time)
+	Type string
+	// GoType is the Go type of the field (e.g. time.Time)
+	GoType string
+	// Gorm is the gorm struct tag value of the model field
+	Gorm string
+	// Validate is the validator struct tag value of the request field, if any
+	Validate string
+	// Sample is a Go expression of the field type used by the generated tests
+	Sample string
+}
+
+// ModelTag returns the struct tag of the field in the model
+func (f ModelField) ModelTag() string {
+	return fmt.Sprintf("`gorm:%q json:%q`", f.Gorm, f.JSON)
+}
+
+// RequestTag returns the struct tag of the field in the request DTO
+func (f ModelField) RequestTag() string {
+	if f.Validate == "" {
+		return fmt.Sprintf("`json:%q`", f.JSON)
+	}
+	return fmt.Sprintf("`json:%q validate:%q`", f.JSON, f.Validate)
+}
+
+// Relation kinds accepted by --fields (name:kind:Model)
+const (
+	RelationBelongsTo  = "belongs_to"
+	RelationHasMany    = "has_many"
+	RelationManyToMany = "many_to_many"
+)
+
+// RelationKinds lists the relation kinds accepted by --fields
+var RelationKinds = []string{RelationBelongsTo, RelationHasMany, RelationManyToMany}
+
+// ModelRelation is a GORM association of a generated model
+type ModelRelation struct {
+	// Name is the Go field name of the association (e.g. Author)
+	Name string
+	// JSON is the snake_case name used in JSON (e.g. author)
+	JSON string
+	// Kind is RelationBelongsTo, RelationHasMany or RelationManyToMany
+	Kind string
+	// Target is the Go type name of the related model (e.g. User)
+	Target string
+	// ForeignKey is the foreign key field: on the model for belongs_to
+	// (e.g. AuthorID), on the target for has_many (e.g. PostID)
+	ForeignKey string
+	// ForeignKeyJSON is the column name of the foreign key (e.g. post_id)
+	ForeignKeyJSON string
+	// JoinTable is the join table of a many_to_many relation (e.g. post_tags)
+	JoinTable string
+	// IDs is the request field listing the related IDs of a many_to_many relation (e.g. TagIDs)
+	IDs string
+	// IDsJSON is the JSON name of IDs (e.g. tag_ids)
+	IDsJSON string
+}
+
+// GoType returns the Go type of the association field
+func (r ModelRelation) GoType() string {
+	if r.Kind == RelationBelongsTo {
+		return "*" + r.Target
+	}
+	return "[]" + r.Target
+}
+
+// ModelTag returns the struct tag of the association field in the model
+func (r ModelRelation) ModelTag() string {
+	var gorm string
+	switch r.Kind {
+	case RelationBelongsTo:
+		gorm = "foreignKey:" + r.ForeignKey + ";constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"
+	case RelationHasMany:
+		gorm = "foreignKey:" + r.ForeignKey
+	case RelationManyToMany:
+		gorm = "many2many:" + r.JoinTable
+	}
+	return fmt.Sprintf("`gorm:%q json:\"%s,omitempty\"`", gorm, r.JSON)
+}
+
+// ResponseType returns the type of the nested DTO of the association
+func (r ModelRelation) ResponseType() string {
+	response := r.Target + "Response"
+	if r.Target == "User" {
+		// The users feature exposes users as profiles
+		response = "ProfileResponse"
+	}
+	if r.Kind == RelationBelongsTo {
+		return "*" + response
+	}
+	return "[]" + response
+}
+
+// ResponseOf returns the expression converting expr, a related model (a
+// pointer when pointer is set), into its nested DTO
+func (r ModelRelation) ResponseOf(expr string, pointer bool) string {
+	if r.Target == "User" {
+		return fmt.Sprintf("ProfileResponse{ID: %[1]s.ID, Email: %[1]s.Email, CreatedAt: %[1]s.CreatedAt.Format(time.RFC3339)}", expr)
+	}
+	if !pointer {
+		expr = "&" + expr
+	}
+	return fmt.Sprintf("new%sResponse(%s)", r.Target, expr)
+}
+
+// ErrorCode returns the API error code of a request referring to missing records
+func (r ModelRelation) ErrorCode() string {
+	if r.Kind == RelationManyToMany {
+		return "INVALID_" + strings.ToUpper(r.IDsJSON)
+	}
+	return "INVALID_" + strings.ToUpper(r.ForeignKeyJSON)
+}
+
+// IDsVar returns the name of the local variable holding the IDs of a many_to_many relation
+func (r ModelRelation) IDsVar() string {
+	return strings.ToLower(r.IDs[:1]) + r.IDs[1:]
+}
+
+// CheckedRelations returns the relations whose records are given by the
+// requests, and must exist: belongs_to and many_to_many
+func (m *Model) CheckedRelations() []ModelRelation {
+	var relations []ModelRelation
+	for _, r := range m.Relations {
+		if r.Kind != RelationHasMany {
+			relations = append(relations, r)
+		}
+	}
+	return relations
+}
+
+// HasRelation reports whether one of the relations is of the given kind
+func (m *Model) HasRelation(kind string) bool {
+	return slices.ContainsFunc(m.Relations, func(r ModelRelation) bool { return r.Kind == kind })
+}
+
+// RelatedModels returns the models the model refers to, without duplicates
+func (m *Model) RelatedModels() []string {
+	var targets []string
+	for _, r := range m.Relations {
+		if !slices.Contains(targets, r.Target) {
+			targets = append(targets, r.Target)
+		}
+	}
+	return targets
+}
+
+// modelFieldType is a field type accepted by --fields
+type modelFieldType struct {
+	Name     string
+	GoType   string
+	Gorm     string
+	Validate string
+	Sample   string
+}
+
+// modelFieldTypes lists the field types accepted by --fields
+var modelFieldTypes = []modelFieldType{
+	{Name: "string", GoType: "string", Gorm: "size:255;not null", Validate: "required,max=255", Sample: `"example"`},
+	{Name: "text", GoType: "string", Gorm: "type:text;not null", Validate: "required", Sample: `"example text"`},
+	{Name: "int", GoType: "int", Gorm: "not null;default:0", Sample: "42"},
+	{Name: "int64", GoType: "int64", Gorm: "not null;default:0", Sample: "42"},
+	{Name: "uint", GoType: "uint", Gorm: "not null;default:0", Sample: "42"},
+	{Name: "float", GoType: "float64", Gorm: "not null;default:0", Sample: "4.5"},
+	{Name: "bool", GoType: "bool", Gorm: "not null;default:false", Sample: "true"},
+	{Name: "time", GoType: "time.Time", Gorm: "not null", Validate: "required", Sample: "time.Date(2026, time.January, 2, 15, 4, 5, 0, time.UTC)"},
+}
+
+// ModelFieldTypeNames returns the names of the field types accepted by --fields
+func ModelFieldTypeNames() []string {
+	names := make([]string, len(modelFieldTypes))
+	for i, t := range modelFieldTypes {
+		names[i] = t.Name
+	}
+	return names
+}
+
+// lookupModelFieldType returns the field type with the given name
+func lookupModelFieldType(name string) (modelFieldType, bool) {
+	for _, t := range modelFieldTypes {
+		if t.Name == name {
+			return t, true
+		}
+	}
+	return modelFieldType{}, false
+}
+
+// HasType reports whether one of the fields has the given --fields type
+func (m *Model) HasType(name string) bool {
+	return slices.ContainsFunc(m.Fields, func(f ModelField) bool { return f.Type == name })
+}
+
+// identifierPattern matches the model and field names accepted on the command line
+var identifierPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]*$`)
+
+// reservedModelNames are the types already declared in internal/models
+var reservedModelNames = []string{"User", "RefreshToken", "AuthResponse"}
+
+// reservedModelPackages are the package names the domain package of a model
+// cannot take: the packages of the project and the ones imported by the
+// generated files
+var reservedModelPackages = []string{
+	"auth", "chi", "config", "context", "database", "domain", "echo", "errors", "fiber",
+	"fmt", "fx", "gin", "gorm", "handlers", "http", "interfaces", "logger", "middleware",
+	"models", "repository", "server", "strconv", "testing", "time", "user", "validator",
+}
+
+// reservedFieldNames are the fields every generated model already has
+var reservedFieldNames = []string{"id", "created_at", "updated_at", "deleted_at"}
+
+// commonInitialisms are the words written in upper case in Go identifiers
+var commonInitialisms = []string{"API", "HTML", "HTTP", "ID", "IP", "JSON", "SQL", "URL", "UUID"}
+
+// ParseModel builds the model named name with the fields of a --fields list
+// ("title:string,body:text,published:bool"). Relations are declared as
+// name:kind:Model ("author:belongs_to:User,tags:many_to_many:Tag").
+func ParseModel(name, fields string) (*Model, error) {
+	if !identifierPattern.MatchString(name) {
+		return nil, fmt.Errorf("invalid model name '%s': use letters, digits, '_' or '-', starting with a letter", name)
+	}
+	words := splitWords(name)
+	m := &Model{
+		Name:    goName(words),
+		Package: strings.ToLower(strings.Join(words, "")),
+		File:    strings.ToLower(strings.Join(words, "_")),
+		Label:   strings.ToLower(strings.Join(words, " ")),
+		Code:    strings.ToUpper(strings.Join(words, "_")),
+	}
+	pluralWords := append(slices.Clone(words[:len(words)-1]), pluralize(words[len(words)-1]))
+	m.Plural = goName(pluralWords)
+	m.Path = strings.ToLower(strings.Join(pluralWords, "-"))
+
+	if slices.Contains(reservedModelNames, m.Name) {
+		return nil, fmt.Errorf("invalid model name '%s': %s is already declared in internal/models", name, m.Name)
+	}
+	if slices.Contains(reservedModelPackages, m.Package) || token.IsKeyword(m.Package) || types.Universe.Lookup(m.Package) != nil {
+		return nil, fmt.Errorf("invalid model name '%s': package name '%s' is reserved", name, m.Package)
+	}
+
+	for _, spec := range strings.Split(fields, ",") {
+		spec = strings.TrimSpace(spec)
+		if spec == "" {
+			continue
+		}
+		if strings.Count(spec, ":") == 2 {
+			if err := m.addRelation(spec); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		field, err := parseModelField(spec)
+		if err != nil {
+			return nil, err
+		}
+		if err := m.addField(field); err != nil {
+			return nil, err
+		}
+	}
+	if len(m.Fields) == 0 && len(m.Relations) == 0 {
+		return nil, fmt.Errorf("at least one field is required (e.g. --fields \"title:string,published:bool\")")
+	}
+	return m, nil
+}
+
+// jsonNames returns the JSON names already used by the fields and relations of the model
+func (m *Model) jsonNames() []string {
+	var names []string
+	for _, f := range m.Fields {
+		names = append(names, f.JSON)
+	}
+	for _, r := range m.Relations {
+		names = append(names, r.JSON)
+		if r.IDsJSON != "" {
+			names = append(names, r.IDsJSON)
+		}
+	}
+	return names
+}
+
+// addField adds field to the model, rejecting duplicate names
+func (m *Model) addField(field ModelField) error {
+	if slices.Contains(m.jsonNames(), field.JSON) {
+		return fmt.Errorf("duplicate field '%s'", field.JSON)
+	}
+	m.Fields = append(m.Fields, field)
+	return nil
+}
+
+// addRelation parses a "name:kind:Model" relation of a --fields list and
+// adds it to the model, with the foreign key field of a belongs_to relation
+func (m *Model) addRelation(spec string) error {
+	parts := strings.Split(spec, ":")
+	name, kind, target := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]), strings.TrimSpace(parts[2])
+	if !identifierPattern.MatchString(name) || !identifierPattern.MatchString(target) {
+		return fmt.Errorf("invalid relation '%s': expected name:kind:Model", spec)
+	}
+	if !slices.Contains(RelationKinds, kind) {
+		return fmt.Errorf("invalid relation '%s': unknown kind '%s', valid kinds are: %s", spec, kind, strings.Join(RelationKinds, ", "))
+	}
+
+	words := splitWords(name)
+	r := ModelRelation{
+		Name:   goName(words),
+		JSON:   strings.ToLower(strings.Join(words, "_")),
+		Kind:   kind,
+		Target: goName(splitWords(target)),
+	}
+	if r.Target == m.Name {
+		return fmt.Errorf("invalid relation '%s': a model cannot refer to itself", spec)
+	}
+	if slices.Contains(reservedFieldNames, r.JSON) {
+		return fmt.Errorf("invalid relation '%s': %s is added to every model", spec, r.JSON)
+	}
+	if slices.Contains(m.jsonNames(), r.JSON) {
+		return fmt.Errorf("duplicate field '%s'", r.JSON)
+	}
+
+	switch kind {
+	case RelationBelongsTo:
+		fkWords := append(slices.Clone(words), "id")
+		r.ForeignKey = goName(fkWords)
+		r.ForeignKeyJSON = strings.Join(fkWords, "_")
+		fk := ModelField{
+			Name:     r.ForeignKey,
+			JSON:     r.ForeignKeyJSON,
+			Type:     "uint",
+			GoType:   "uint",
+			Gorm:     "not null;index",
+			Validate: "required",
+			Sample:   "1",
+		}
+		if err := m.addField(fk); err != nil {
+			return err
+		}
+	case RelationHasMany:
+		r.ForeignKey = m.Name + "ID"
+		r.ForeignKeyJSON = m.File + "_id"
+	case RelationManyToMany:
+		r.JoinTable = m.File + "_" + r.JSON
+		idWords := append(splitWords(target), "ids")
+		r.IDs = goName(idWords[:len(idWords)-1]) + "IDs"
+		r.IDsJSON = strings.Join(idWords, "_")
+		if slices.Contains(m.jsonNames(), r.IDsJSON) {
+			return fmt.Errorf("duplicate field '%s'", r.IDsJSON)
+		}
+	}
+	m.Relations = append(m.Relations, r)
+	return nil
+}
+
+// parseModelField parses a "name:type" field of a --fields list
+func parseModelField(spec string) (ModelField, error) {
+	name, typeName, ok := strings.Cut(spec, ":")
+	name, typeName = strings.TrimSpace(name), strings.TrimSpace(typeName)
+	if !ok || name == "" || typeName == "" {
+		return ModelField{}, fmt.Errorf("invalid field '%s': expected name:type", spec)
+	}
+	if !identifierPattern.MatchString(name) {
+		return ModelField{}, fmt.Errorf("invalid field '%s': use letters, digits, '_' or '-', starting with a letter", spec)
+	}
+	fieldType, ok := lookupModelFieldType(typeName)
+	if !ok {
+		return ModelField{}, fmt.Errorf("invalid field '%s': unknown type '%s', valid types are: %s", spec, typeName, strings.Join(ModelFieldTypeNames(), ", "))
+	}
+
+	words := splitWords(name)
+	field := ModelField{
+		Name:     goName(words),
+		JSON:     strings.ToLower(strings.Join(words, "_")),
+		Type:     fieldType.Name,
+		GoType:   fieldType.GoType,
+		Gorm:     fieldType.Gorm,
+		Validate: fieldType.Validate,
+		Sample:   fieldType.Sample,
+	}
+	if slices.Contains(reservedFieldNames, field.JSON) {
+		return ModelField{}, fmt.Errorf("invalid field '%s': %s is added to every model", spec, field.JSON)
+	}
+	return field, nil
+}
+
+// splitWords splits an identifier into its words at '_', '-' and case changes
+// ("blogPost", "blog_post" and "BlogPost" all give [blog post])
+func splitWords(name string) []string {
+	var words []string
+	var word []rune
+	runes := []rune(name)
+	for i, r := range runes {
+		if r == '_' || r == '-' {
+			if len(word) > 0 {
+				words = append(words, string(word))
+			}
+			word = nil
+			continue
+		}
+		if unicode.IsUpper(r) && len(word) > 0 {
+			prevLower := unicode.IsLower(word[len(word)-1]) || unicode.IsDigit(word[len(word)-1])
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			// Split "blogPost" before P, and "HTTPServer" before S
+			if prevLower || nextLower {
+				words = append(words, string(word))
+				word = nil
+			}
+		}
+		word = append(word, r)
+	}
+	if len(word) > 0 {
+		words = append(words, string(word))
+	}
+	for i, w := range words {
+		words[i] = strings.ToLower(w)
+	}
+	return words
+}
+
+// goName joins words into an exported Go identifier, writing common
+// initialisms in upper case ([author id] gives AuthorID)
+func goName(words []string) string {
+	var b strings.Builder
+	for _, w := range words {
+		if upper := strings.ToUpper(w); slices.Contains(commonInitialisms, upper) {
+			b.WriteString(upper)
+			continue
+		}
+		b.WriteString(strings.ToUpper(w[:1]) + w[1:])
+	}
+	return b.String()
+}
+
+// pluralize returns the English plural of a lower case word
+func pluralize(word string) string {
+	switch {
+	case strings.HasSuffix(word, "y") && len(word) > 1 && !strings.ContainsRune("aeiou", rune(word[len(word)-2])):
+		return word[:len(word)-1] + "ies"
+	case strings.HasSuffix(word, "s"), strings.HasSuffix(word, "x"), strings.HasSuffix(word, "z"),
+		strings.HasSuffix(word, "ch"), strings.HasSuffix(word, "sh"):
+		return word + "es"
+	default:
+		return word + "s"
+	}
+}
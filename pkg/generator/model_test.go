@@ -0,0 +1,129 @@
+package generator
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseModelNaming(t *testing.T) {
+	tests := []struct {
+		name string
+		want Model
+	}{
+		{"Post", Model{Name: "Post", Plural: "Posts", Package: "post", File: "post", Path: "posts", Label: "post", Code: "POST"}},
+		{"blog_post", Model{Name: "BlogPost", Plural: "BlogPosts", Package: "blogpost", File: "blog_post", Path: "blog-posts", Label: "blog post", Code: "BLOG_POST"}},
+		{"BlogPost", Model{Name: "BlogPost", Plural: "BlogPosts", Package: "blogpost", File: "blog_post", Path: "blog-posts", Label: "blog post", Code: "BLOG_POST"}},
+		{"category", Model{Name: "Category", Plural: "Categories", Package: "category", File: "category", Path: "categories", Label: "category", Code: "CATEGORY"}},
+		{"Address", Model{Name: "Address", Plural: "Addresses", Package: "address", File: "address", Path: "addresses", Label: "address", Code: "ADDRESS"}},
+		{"APIKey", Model{Name: "APIKey", Plural: "APIKeys", Package: "apikey", File: "api_key", Path: "api-keys", Label: "api key", Code: "API_KEY"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := ParseModel(tt.name, "title:string")
+			if err != nil {
+				t.Fatalf("ParseModel() error = %v", err)
+			}
+			m.Fields = nil
+			if !reflect.DeepEqual(*m, tt.want) {
+				t.Errorf("ParseModel(%q) = %+v, want %+v", tt.name, *m, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseModelFields(t *testing.T) {
+	m, err := ParseModel("Post", " title:string, author_id:uint ,publishedAt:time,,score:float")
+	if err != nil {
+		t.Fatalf("ParseModel() error = %v", err)
+	}
+
+	want := []struct{ name, json, goType, tag string }{
+		{"Title", "title", "string", "`json:\"title\" validate:\"required,max=255\"`"},
+		{"AuthorID", "author_id", "uint", "`json:\"author_id\"`"},
+		{"PublishedAt", "published_at", "time.Time", "`json:\"published_at\" validate:\"required\"`"},
+		{"Score", "score", "float64", "`json:\"score\"`"},
+	}
+	if len(m.Fields) != len(want) {
+		t.Fatalf("got %d fields, want %d: %+v", len(m.Fields), len(want), m.Fields)
+	}
+	for i, w := range want {
+		f := m.Fields[i]
+		if f.Name != w.name || f.JSON != w.json || f.GoType != w.goType || f.RequestTag() != w.tag {
+			t.Errorf("field %d = %s %s %s %s, want %s %s %s %s", i, f.Name, f.JSON, f.GoType, f.RequestTag(), w.name, w.json, w.goType, w.tag)
+		}
+	}
+	if got := m.Fields[0].ModelTag(); got != "`gorm:\"size:255;not null\" json:\"title\"`" {
+		t.Errorf("ModelTag() = %s", got)
+	}
+	if !m.HasType("time") || m.HasType("bool") {
+		t.Errorf("HasType() should report the time field only")
+	}
+}
+
+func TestParseModelRelations(t *testing.T) {
+	m, err := ParseModel("BlogPost", "title:string,author:belongs_to:User,comments:has_many:Comment,tags:many_to_many:tag")
+	if err != nil {
+		t.Fatalf("ParseModel() error = %v", err)
+	}
+
+	// belongs_to adds its foreign key to the fields
+	if len(m.Fields) != 2 || m.Fields[1].Name != "AuthorID" || m.Fields[1].ModelTag() != "`gorm:\"not null;index\" json:\"author_id\"`" {
+		t.Errorf("Fields = %+v, want title and author_id", m.Fields)
+	}
+
+	want := []struct{ name, goType, tag, response string }{
+		{"Author", "*User", "`gorm:\"foreignKey:AuthorID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT\" json:\"author,omitempty\"`", "*ProfileResponse"},
+		{"Comments", "[]Comment", "`gorm:\"foreignKey:BlogPostID\" json:\"comments,omitempty\"`", "[]CommentResponse"},
+		{"Tags", "[]Tag", "`gorm:\"many2many:blog_post_tags\" json:\"tags,omitempty\"`", "[]TagResponse"},
+	}
+	if len(m.Relations) != len(want) {
+		t.Fatalf("got %d relations, want %d", len(m.Relations), len(want))
+	}
+	for i, w := range want {
+		r := m.Relations[i]
+		if r.Name != w.name || r.GoType() != w.goType || r.ModelTag() != w.tag || r.ResponseType() != w.response {
+			t.Errorf("relation %d = %s %s %s %s, want %s %s %s %s", i, r.Name, r.GoType(), r.ModelTag(), r.ResponseType(), w.name, w.goType, w.tag, w.response)
+		}
+	}
+	if tags := m.Relations[2]; tags.IDs != "TagIDs" || tags.IDsJSON != "tag_ids" || tags.ErrorCode() != "INVALID_TAG_IDS" {
+		t.Errorf("many_to_many IDs = %s %s %s", tags.IDs, tags.IDsJSON, tags.ErrorCode())
+	}
+	if got := m.RelatedModels(); !reflect.DeepEqual(got, []string{"User", "Comment", "Tag"}) {
+		t.Errorf("RelatedModels() = %v", got)
+	}
+	if got := len(m.CheckedRelations()); got != 2 {
+		t.Errorf("CheckedRelations() = %d relations, want belongs_to and many_to_many", got)
+	}
+}
+
+func TestParseModelErrors(t *testing.T) {
+	tests := []struct {
+		name, model, fields, wantErr string
+	}{
+		{"invalid name", "9lives", "a:string", "invalid model name '9lives'"},
+		{"reserved model", "user", "a:string", "User is already declared"},
+		{"reserved package", "Config", "a:string", "package name 'config' is reserved"},
+		{"keyword package", "Type", "a:string", "package name 'type' is reserved"},
+		{"no fields", "Post", "", "at least one field is required"},
+		{"missing type", "Post", "title", "invalid field 'title': expected name:type"},
+		{"unknown type", "Post", "title:varchar", "unknown type 'varchar'"},
+		{"invalid field name", "Post", "ti tle:string", "invalid field 'ti tle:string'"},
+		{"reserved field", "Post", "createdAt:time", "created_at is added to every model"},
+		{"duplicate field", "Post", "title:string,Title:text", "duplicate field 'title'"},
+		{"unknown relation", "Post", "author:has_one:User", "unknown kind 'has_one'"},
+		{"invalid relation target", "Post", "author:belongs_to:", "expected name:kind:Model"},
+		{"self relation", "Category", "parent:belongs_to:Category", "cannot refer to itself"},
+		{"foreign key clash", "Post", "author_id:uint,author:belongs_to:User", "duplicate field 'author_id'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseModel(tt.model, tt.fields)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseModel(%q, %q) error = %v, want %q", tt.model, tt.fields, err, tt.wantErr)
+			}
+		})
+	}
+}
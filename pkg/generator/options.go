@@ -0,0 +1,197 @@
+package generator
+
+import (
+	"fmt"
+	"io/fs"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/tky0065/go-starter-kit/pkg/utils"
+)
+
+// Supported values for the project spec fields
+var (
+	// ValidDatabases lists the database drivers a project can be generated with
+	ValidDatabases = databaseNames()
+	// ValidFrameworks lists the HTTP routers a project can be generated with
+	ValidFrameworks = frameworkNames()
+	// ValidCIProviders lists the CI systems workflow files can be generated for
+	ValidCIProviders = []string{"github", "none"}
+	// ValidLicenses lists the licenses that can be added to a project
+	ValidLicenses = []string{"none", "MIT"}
+)
+
+// GitOptions controls the git repository created for the project
+type GitOptions struct {
+	// Init initializes a repository with an initial commit
+	Init bool `yaml:"init" json:"init"`
+	// Branch is the branch of the initial commit; empty keeps the default of
+	// git (init.defaultBranch)
+	Branch string `yaml:"branch,omitempty" json:"branch,omitempty"`
+	// Remote is the URL recorded as the origin remote. Nothing is pushed.
+	Remote string `yaml:"remote,omitempty" json:"remote,omitempty"`
+	// Author overrides the author and committer of the initial commit, as
+	// "Name <email>"; empty uses the git configuration
+	Author string `yaml:"author,omitempty" json:"author,omitempty"`
+	// InstallHooks installs pre-commit and pre-push hooks running gofmt,
+	// go vet and golangci-lint
+	InstallHooks bool `yaml:"install_hooks,omitempty" json:"install_hooks,omitempty"`
+}
+
+// ProjectOptions describes the project to generate. It doubles as the project
+// spec loaded with --config and written to the generated project.
+type ProjectOptions struct {
+	// ProjectName is the project directory, binary and display name
+	ProjectName string `yaml:"name" json:"name"`
+	// ModulePath is the Go module path (e.g. github.com/org/service).
+	// When empty, ProjectName is used as the module path.
+	ModulePath string `yaml:"module,omitempty" json:"module,omitempty"`
+	// Template is the name of a registered template
+	Template string `yaml:"template" json:"template"`
+	// Features lists the optional features enabled on top of the template
+	Features []string `yaml:"features,omitempty" json:"features,omitempty"`
+	// Framework is the HTTP router
+	Framework string `yaml:"framework" json:"framework"`
+	// Database is the database driver
+	Database string `yaml:"database" json:"database"`
+	// CI is the CI provider workflow files are generated for
+	CI string `yaml:"ci" json:"ci"`
+	// Git controls the git repository initialization
+	Git GitOptions `yaml:"git" json:"git"`
+	// License is the license added to the project ("none" for no license)
+	License string `yaml:"license" json:"license"`
+	// Variables sets template variables, available to the templates as .Vars.
+	// Template packs declare their variables with a default value.
+	Variables map[string]string `yaml:"variables,omitempty" json:"variables,omitempty"`
+	// Hooks are the commands and Go hooks run before rendering and after
+	// writing, after those of the template
+	Hooks Hooks `yaml:"hooks,omitempty" json:"hooks,omitzero"`
+	// Overrides holds files replacing or adding generated files, with the
+	// layout of the project. They are rendered like template files, then
+	// copied to OverridesDir in the project. It is not part of the spec.
+	Overrides fs.FS `yaml:"-" json:"-"`
+	// Verify runs go mod tidy, vet, build and test in the generated project
+	// (--verify). It controls a single run and is not part of the spec.
+	Verify bool `yaml:"-" json:"-"`
+}
+
+// DefaultProjectOptions returns the options used when nothing is specified
+func DefaultProjectOptions() ProjectOptions {
+	return ProjectOptions{
+		Template:  DefaultTemplate,
+		Framework: DefaultFramework,
+		Database:  DefaultDBDriver,
+		CI:        DefaultCIProvider,
+		Git:       GitOptions{Init: true},
+		License:   "none",
+	}
+}
+
+// WithDefaults fills the empty fields of o with their default values
+func (o ProjectOptions) WithDefaults() ProjectOptions {
+	defaults := DefaultProjectOptions()
+	if o.Template == "" {
+		o.Template = defaults.Template
+	}
+	if o.Framework == "" {
+		o.Framework = defaults.Framework
+	}
+	if o.Database == "" {
+		o.Database = defaults.Database
+	}
+	if o.CI == "" {
+		o.CI = defaults.CI
+	}
+	if o.License == "" {
+		o.License = defaults.License
+	}
+	return o
+}
+
+// Module returns the Go module path of the project: ModulePath, or the project name
+func (o ProjectOptions) Module() string {
+	if o.ModulePath == "" {
+		return o.ProjectName
+	}
+	return o.ModulePath
+}
+
+// Validate checks every field of the options
+func (o ProjectOptions) Validate() error {
+	o = o.WithDefaults()
+
+	if err := utils.ValidateGoModuleName(o.ProjectName); err != nil {
+		return err
+	}
+	if o.ModulePath != "" {
+		if err := utils.ValidateModulePath(o.ModulePath); err != nil {
+			return err
+		}
+	}
+	if err := ValidateTemplate(o.Template); err != nil {
+		return err
+	}
+	if _, err := ResolveFeatures(o.Template, o.Features); err != nil {
+		return err
+	}
+	if err := validateChoice("framework", o.Framework, ValidFrameworks); err != nil {
+		return err
+	}
+	if err := validateChoice("database", o.Database, ValidDatabases); err != nil {
+		return err
+	}
+	if err := validateChoice("CI provider", o.CI, ValidCIProviders); err != nil {
+		return err
+	}
+	for name := range o.Variables {
+		if err := validateVariableName(name); err != nil {
+			return err
+		}
+	}
+	if err := validateGitOptions(o.Git); err != nil {
+		return err
+	}
+	if err := ResolveHooks(o).validate(); err != nil {
+		return err
+	}
+	return validateChoice("license", o.License, ValidLicenses)
+}
+
+// validateChoice checks that value is one of valid
+func validateChoice(kind, value string, valid []string) error {
+	if slices.Contains(valid, value) {
+		return nil
+	}
+	return fmt.Errorf("invalid %s '%s': valid options are: %s", kind, value, strings.Join(valid, ", "))
+}
+
+// validateVariableName checks that a template variable can be used as .Vars.name
+func validateVariableName(name string) error {
+	if !variableName.MatchString(name) {
+		return fmt.Errorf("invalid variable name '%s': use letters, digits and underscores, starting with a letter", name)
+	}
+	return nil
+}
+
+// variableName matches the names of the template variables
+var variableName = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// TemplateData returns the context the template files are rendered against
+func (o ProjectOptions) TemplateData() TemplateData {
+	o = o.WithDefaults()
+
+	data := newTemplateData(o.ProjectName)
+	data.ModulePath = o.Module()
+	// Validated options always resolve; invalid ones render without features
+	data.Features, _ = ResolveFeatures(o.Template, o.Features)
+	data.DBDriver = o.Database
+	data.HTTPFramework = o.Framework
+	data.CIProvider = o.CI
+	if o.License != "none" {
+		data.License = o.License
+	}
+	data.Vars = maps.Clone(o.Variables)
+	return data
+}
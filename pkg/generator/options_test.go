@@ -0,0 +1,58 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+// testProjectOptions returns the default options for the given project
+func testProjectOptions(projectName, template string) ProjectOptions {
+	opts := DefaultProjectOptions()
+	opts.ProjectName = projectName
+	opts.Template = template
+	return opts
+}
+
+func TestProjectOptionsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    ProjectOptions
+		wantErr string
+	}{
+		{"name only", ProjectOptions{ProjectName: "svc", Template: TemplateFull}, ""},
+		{"full module path", ProjectOptions{ProjectName: "svc", ModulePath: "github.com/our-org/svc", Template: TemplateFull}, ""},
+		{"major version suffix", ProjectOptions{ProjectName: "svc", ModulePath: "github.com/our-org/svc/v2", Template: TemplateMinimal}, ""},
+		{"missing dot in first element", ProjectOptions{ProjectName: "svc", ModulePath: "our-org/svc", Template: TemplateFull}, "missing dot in first path element"},
+		{"invalid character", ProjectOptions{ProjectName: "svc", ModulePath: "github.com/our org/svc", Template: TemplateFull}, "invalid module path"},
+		{"trailing slash", ProjectOptions{ProjectName: "svc", ModulePath: "github.com/our-org/svc/", Template: TemplateFull}, "invalid module path"},
+		{"invalid directory", ProjectOptions{ProjectName: "../svc", Template: TemplateFull}, "invalid module name"},
+		{"invalid template", ProjectOptions{ProjectName: "svc", Template: "nope"}, "invalid template"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.opts.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("validate() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("validate() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestProjectOptionsTemplateData(t *testing.T) {
+	data := ProjectOptions{ProjectName: "svc", Template: TemplateFull}.TemplateData()
+	if data.ModulePath != "svc" {
+		t.Errorf("ModulePath should default to the project name, got %q", data.ModulePath)
+	}
+
+	data = ProjectOptions{ProjectName: "svc", ModulePath: "github.com/our-org/svc"}.TemplateData()
+	if data.ProjectName != "svc" || data.ModulePath != "github.com/our-org/svc" {
+		t.Errorf("unexpected template data: %+v", data)
+	}
+}
@@ -0,0 +1,80 @@
+package generator
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// OverridesDir is where a project keeps its override files, relative to the
+// project root. The files of ProjectOptions.Overrides are copied there so that
+// upgrade renders the project with them again.
+const OverridesDir = ".go-starter/overrides"
+
+// LoadOverrides returns the override files in dir, or nil if dir does not exist
+func LoadOverrides(dir string) (fs.FS, error) {
+	info, err := os.Stat(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, &fs.PathError{Op: "open", Path: dir, Err: errors.New("not a directory")}
+	}
+	return os.DirFS(dir), nil
+}
+
+// applyOverrides renders the override files with data and puts them in place
+// of the template files with the same path, or adds them. An override that
+// renders to whitespace only removes the file. The override files are also
+// added, as they are, under OverridesDir.
+func applyOverrides(projectPath string, files []FileGenerator, overrides fs.FS, data TemplateData) ([]FileGenerator, error) {
+	tree, paths, err := parseTemplateFiles(overrides)
+	if err != nil {
+		return nil, err
+	}
+	rendered, err := renderTemplateFiles(tree, paths, data)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []FileGenerator
+	for _, file := range files {
+		rel, err := projectRelPath(projectPath, file.Path)
+		if err != nil {
+			return nil, err
+		}
+		content, ok := rendered[rel]
+		if !ok {
+			result = append(result, file)
+			continue
+		}
+		delete(rendered, rel)
+		if content != "" {
+			result = append(result, FileGenerator{Path: file.Path, Content: content})
+		}
+	}
+	for _, p := range paths {
+		target := strings.TrimSuffix(p, templateExt)
+		if content := rendered[target]; content != "" {
+			result = append(result, FileGenerator{Path: filepath.Join(projectPath, filepath.FromSlash(target)), Content: content})
+		}
+	}
+
+	for _, p := range paths {
+		content, err := fs.ReadFile(overrides, p)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, FileGenerator{
+			Path:    filepath.Join(projectPath, filepath.FromSlash(path.Join(OverridesDir, p))),
+			Content: string(content),
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,83 @@
+package generator
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+// testOverrides replaces the Dockerfile, adds a file and removes the license
+var testOverrides = fstest.MapFS{
+	"Dockerfile.tmpl":       {Data: []byte("FROM registry.example.com/go:1.25\n# {{.ProjectName}} ({{.ModulePath}})\n")},
+	"deployments/team.yaml": {Data: []byte("team: {{.Vars.team}}\n")},
+	"LICENSE.tmpl":          {Data: []byte("\n")},
+}
+
+func TestGenerateWithOverrides(t *testing.T) {
+	opts := testProjectOptions("override-app", TemplateMinimal)
+	opts.ModulePath = "example.com/override-app"
+	opts.License = "MIT"
+	opts.Variables = map[string]string{"team": "payments"}
+	opts.Overrides = testOverrides
+
+	sink := NewMemorySink()
+	if err := Generate(context.Background(), opts, sink, nil); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	want := "FROM registry.example.com/go:1.25\n# override-app (example.com/override-app)\n"
+	if got := string(sink.Files["Dockerfile"].Content); got != want {
+		t.Errorf("Dockerfile = %q, want %q", got, want)
+	}
+	if got := string(sink.Files["deployments/team.yaml"].Content); got != "team: payments\n" {
+		t.Errorf("deployments/team.yaml = %q", got)
+	}
+	if _, ok := sink.Files["LICENSE"]; ok {
+		t.Error("an override rendering to whitespace should remove the file")
+	}
+	// The overrides are kept, as they are, for upgrade
+	if got := string(sink.Files[OverridesDir+"/Dockerfile.tmpl"].Content); !strings.Contains(got, "{{.ProjectName}}") {
+		t.Errorf("%s/Dockerfile.tmpl = %q", OverridesDir, got)
+	}
+
+	opts.Overrides = fstest.MapFS{"Dockerfile": {Data: []byte("FROM {{.Vars.missing}}\n")}}
+	if err := Generate(context.Background(), opts, NewMemorySink(), nil); err == nil || !strings.Contains(err.Error(), "Dockerfile") {
+		t.Errorf("Generate() error = %v, want the failing override named", err)
+	}
+}
+
+// TestUpgradeWithOverrides tests that upgrade renders the project with the
+// overrides it carries
+func TestUpgradeWithOverrides(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "override-app")
+	opts := testProjectOptions("override-app", TemplateMinimal)
+	opts.Variables = map[string]string{"team": "payments"}
+	opts.Overrides = testOverrides
+	if err := Create(context.Background(), opts, dir, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := Upgrade(dir, true)
+	if err != nil {
+		t.Fatalf("Upgrade() error = %v", err)
+	}
+	if result.Changed() {
+		t.Errorf("a project generated with overrides should be up to date, got %+v", result)
+	}
+
+	// Editing an override in the project changes the file it renders
+	override := filepath.Join(dir, filepath.FromSlash(OverridesDir), "Dockerfile.tmpl")
+	if err := os.WriteFile(override, []byte("FROM scratch\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	result, err = Upgrade(dir, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Updated) != 1 || result.Updated[0] != "Dockerfile" {
+		t.Errorf("Updated = %v, want [Dockerfile]", result.Updated)
+	}
+}
@@ -0,0 +1,243 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TemplatePackManifest is the file describing a template pack, at the root of
+// the pack directory
+const TemplatePackManifest = "template.yaml"
+
+// templatePackFiles is the directory of the pack files when the manifest does
+// not name one
+const templatePackFiles = "files"
+
+// templatePackManifest is the content of TemplatePackManifest
+type templatePackManifest struct {
+	// Name identifies the template, as accepted by --template
+	Name string `yaml:"name"`
+	// Description is the one-line summary shown in the help output
+	Description string `yaml:"description"`
+	// Directories lists the directories to create, relative to the project root
+	Directories []string `yaml:"directories"`
+	// Files is the directory of the pack holding the project files
+	Files string `yaml:"files"`
+	// Variables declares the template variables with their default value
+	Variables map[string]string `yaml:"variables"`
+	// Hooks are run before rendering and after writing the project
+	Hooks Hooks `yaml:"hooks"`
+}
+
+// templatePack is a Template loaded from a directory on disk rather than
+// compiled in. Its files mirror the layout of the generated project, like a
+// layer of the embedded tree, and are rendered with the same context: the
+// variables declared by the pack are available as .Vars.
+type templatePack struct {
+	manifest templatePackManifest
+	// tree holds the parsed pack files, keyed by their path in the files
+	// directory, along with the embedded tree so that packs can include its
+	// partials
+	tree *template.Template
+	// paths lists the pack files, sorted
+	paths []string
+	// dir is the pack directory the hook commands are relative to, when
+	// loaded with LoadTemplateDir
+	dir string
+}
+
+// LoadTemplateDir loads the template pack in dir. See LoadTemplatePack. The
+// relative command paths of its hooks are resolved against dir.
+func LoadTemplateDir(dir string) (Template, error) {
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		return nil, fmt.Errorf("template directory %s not found", dir)
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := LoadTemplatePack(os.DirFS(abs))
+	if err != nil {
+		return nil, err
+	}
+	pack := tmpl.(*templatePack)
+	pack.dir = abs
+	return pack, nil
+}
+
+// LoadTemplatePack loads a template pack: a TemplatePackManifest naming the
+// template and declaring its directories and variables, and a directory of
+// files ("files" by default) rendered into the project. It may also declare
+// hooks. Files with a .tmpl
+// suffix have it stripped, like in the embedded tree. The returned Template is
+// not registered: see RegisterTemplate.
+func LoadTemplatePack(fsys fs.FS) (Template, error) {
+	content, err := fs.ReadFile(fsys, TemplatePackManifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read template pack manifest: %w", err)
+	}
+	var m templatePackManifest
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&m); err != nil {
+		return nil, fmt.Errorf("invalid template pack manifest %s: %w", TemplatePackManifest, err)
+	}
+	if m.Name == "" {
+		return nil, fmt.Errorf("invalid template pack manifest %s: name is required", TemplatePackManifest)
+	}
+	if m.Files == "" {
+		m.Files = templatePackFiles
+	}
+	// The files come from fsys and stay in the project: the directories must too
+	for i, dir := range m.Directories {
+		if !fs.ValidPath(path.Clean(dir)) || path.Clean(dir) == "." {
+			return nil, fmt.Errorf("invalid template pack manifest %s: directory %q is not a path inside the project", TemplatePackManifest, dir)
+		}
+		m.Directories[i] = path.Clean(dir)
+	}
+	for name := range m.Variables {
+		if err := validateVariableName(name); err != nil {
+			return nil, fmt.Errorf("invalid template pack manifest %s: %w", TemplatePackManifest, err)
+		}
+	}
+
+	if err := m.Hooks.validate(); err != nil {
+		return nil, fmt.Errorf("invalid template pack manifest %s: %w", TemplatePackManifest, err)
+	}
+
+	if info, err := fs.Stat(fsys, path.Clean(m.Files)); err != nil || !info.IsDir() {
+		return nil, fmt.Errorf("template pack %s has no files in %s/", m.Name, m.Files)
+	}
+	files, err := fs.Sub(fsys, path.Clean(m.Files))
+	if err != nil {
+		return nil, err
+	}
+	tree, paths, err := parseTemplateFiles(files)
+	if err != nil {
+		return nil, fmt.Errorf("template pack %s: %w", m.Name, err)
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("template pack %s has no files in %s/", m.Name, m.Files)
+	}
+	return &templatePack{manifest: m, tree: tree, paths: paths}, nil
+}
+
+// parseTemplateFiles parses every file of fsys into a copy of the embedded
+// template tree, each under its path in fsys. It returns the paths, sorted.
+func parseTemplateFiles(fsys fs.FS) (*template.Template, []string, error) {
+	embedded, err := parsedTemplates()
+	if err != nil {
+		return nil, nil, err
+	}
+	tree, err := embedded.Clone()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var paths []string
+	err = fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		content, err := fs.ReadFile(fsys, p)
+		if err != nil {
+			return err
+		}
+		if _, err := tree.New(p).Parse(string(content)); err != nil {
+			return fmt.Errorf("failed to parse template %s: %w", p, err)
+		}
+		paths = append(paths, p)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	slices.Sort(paths)
+	return tree, paths, nil
+}
+
+// renderTemplateFiles renders the files parsed by parseTemplateFiles with
+// data. A file that renders to whitespace only is returned as an empty string
+// so that callers can drop it, and Go files are formatted, like renderLayers
+// does.
+func renderTemplateFiles(tree *template.Template, paths []string, data TemplateData) (map[string]string, error) {
+	contents := make(map[string]string, len(paths))
+	for _, p := range paths {
+		var buf bytes.Buffer
+		if err := tree.ExecuteTemplate(&buf, p, data); err != nil {
+			return nil, fmt.Errorf("failed to render template %s: %w", p, err)
+		}
+		content := buf.String()
+		target := strings.TrimSuffix(p, templateExt)
+		switch {
+		case strings.TrimSpace(content) == "":
+			content = ""
+		case isGoFile(target):
+			var err error
+			if content, err = formatGoSource(p, data.ModulePath, content); err != nil {
+				return nil, err
+			}
+		}
+		contents[target] = content
+	}
+	return contents, nil
+}
+
+func (t *templatePack) Name() string        { return t.manifest.Name }
+func (t *templatePack) Description() string { return t.manifest.Description }
+
+func (t *templatePack) Directories() []string {
+	return slices.Clone(t.manifest.Directories)
+}
+
+// PostGenerationSteps copies .env.example to .env when the pack has one
+func (t *templatePack) PostGenerationSteps() []PostGenerationStep {
+	for _, p := range t.paths {
+		if strings.TrimSuffix(p, templateExt) == ".env.example" {
+			return []PostGenerationStep{copyEnvStep}
+		}
+	}
+	return nil
+}
+
+// Hooks returns the hooks declared by the pack
+func (t *templatePack) Hooks() Hooks {
+	return t.manifest.Hooks.withBase(t.dir)
+}
+
+// Files renders the pack files, with the pack variables defaulting the values
+// of data.Vars
+func (t *templatePack) Files(projectPath string, data TemplateData) ([]FileGenerator, error) {
+	vars := maps.Clone(t.manifest.Variables)
+	if vars == nil {
+		vars = map[string]string{}
+	}
+	maps.Copy(vars, data.Vars)
+	data.Vars = vars
+
+	contents, err := renderTemplateFiles(t.tree, t.paths, data)
+	if err != nil {
+		return nil, err
+	}
+	var files []FileGenerator
+	for _, p := range slices.Sorted(maps.Keys(contents)) {
+		if contents[p] == "" {
+			continue
+		}
+		files = append(files, FileGenerator{
+			Path:    filepath.Join(projectPath, filepath.FromSlash(p)),
+			Content: contents[p],
+		})
+	}
+	return files, nil
+}
@@ -0,0 +1,134 @@
+package generator
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+// testPack is a template pack with variables, a file without the .tmpl suffix
+// and a conditional file
+var testPack = fstest.MapFS{
+	TemplatePackManifest: {Data: []byte(`name: ourservice
+description: Our service skeleton
+directories: [cmd, internal/app]
+variables:
+  team: platform
+  base_image: golang:1.25
+`)},
+	"files/go.mod.tmpl":      {Data: []byte("module {{.ModulePath}}\n\ngo 1.25\n")},
+	"files/cmd/main.go.tmpl": {Data: []byte("package main\n\n// Owned by {{.Vars.team}}\nfunc main() {}\n")},
+	"files/Dockerfile":       {Data: []byte("FROM {{.Vars.base_image}}\n")},
+	"files/LICENSE.tmpl":     {Data: []byte("{{if .License}}{{.License}}{{end}}\n")},
+	"files/.env.example":     {Data: []byte("APP_NAME={{.ProjectName}}\n")},
+}
+
+func TestLoadTemplatePack(t *testing.T) {
+	tmpl, err := LoadTemplatePack(testPack)
+	if err != nil {
+		t.Fatalf("LoadTemplatePack() error = %v", err)
+	}
+	if tmpl.Name() != "ourservice" || tmpl.Description() != "Our service skeleton" {
+		t.Errorf("template = %s: %s", tmpl.Name(), tmpl.Description())
+	}
+	if dirs := tmpl.Directories(); len(dirs) != 2 || dirs[1] != "internal/app" {
+		t.Errorf("Directories() = %v", dirs)
+	}
+	if steps := tmpl.PostGenerationSteps(); len(steps) != 1 || steps[0].Name != copyEnvStep.Name {
+		t.Errorf("a pack with .env.example should copy it to .env, got steps %v", steps)
+	}
+
+	data := newTemplateData("svc")
+	data.ModulePath = "example.com/svc"
+	data.Vars = map[string]string{"team": "payments"}
+	files, err := tmpl.Files("", data)
+	if err != nil {
+		t.Fatalf("Files() error = %v", err)
+	}
+	contents := map[string]string{}
+	for _, f := range files {
+		contents[f.Path] = f.Content
+	}
+	if _, ok := contents["LICENSE"]; ok {
+		t.Error("a file rendering to whitespace should not be generated")
+	}
+	if !strings.HasPrefix(contents["go.mod"], "module example.com/svc") {
+		t.Errorf("go.mod = %q", contents["go.mod"])
+	}
+	if !strings.Contains(contents["cmd/main.go"], "Owned by payments") {
+		t.Errorf("cmd/main.go should use the variable set by the options:\n%s", contents["cmd/main.go"])
+	}
+	if contents["Dockerfile"] != "FROM golang:1.25\n" {
+		t.Errorf("Dockerfile should use the default of the variable, got %q", contents["Dockerfile"])
+	}
+}
+
+func TestLoadTemplatePackErrors(t *testing.T) {
+	files := fstest.MapFS{"files/go.mod.tmpl": {Data: []byte("module {{.ModulePath}}\n")}}
+	tests := []struct {
+		name, manifest, want string
+	}{
+		{"no name", "description: x\n", "name is required"},
+		{"unknown field", "name: x\nlayers: [common]\n", "field layers not found"},
+		{"invalid variable", "name: x\nvariables:\n  base-image: x\n", "invalid variable name"},
+		{"no files", "name: x\nfiles: templates\n", "no files"},
+		{"parent directory", "name: x\ndirectories: [internal, ../outside]\n", `directory "../outside"`},
+		{"nested parent directory", "name: x\ndirectories: [internal/../../outside]\n", "not a path inside the project"},
+		{"absolute directory", "name: x\ndirectories: [/etc/app]\n", `directory "/etc/app"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pack := fstest.MapFS{TemplatePackManifest: {Data: []byte(tt.manifest)}}
+			for name, f := range files {
+				pack[name] = f
+			}
+			_, err := LoadTemplatePack(pack)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("LoadTemplatePack() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+
+	pack := fstest.MapFS{
+		TemplatePackManifest: {Data: []byte("name: x\n")},
+		"files/main.go.tmpl": {Data: []byte("{{.Vars.team\n")},
+	}
+	if _, err := LoadTemplatePack(pack); err == nil || !strings.Contains(err.Error(), "main.go.tmpl") {
+		t.Errorf("LoadTemplatePack() error = %v, want the broken file named", err)
+	}
+}
+
+func TestGenerateTemplatePack(t *testing.T) {
+	tmpl, err := LoadTemplatePack(testPack)
+	if err != nil {
+		t.Fatal(err)
+	}
+	registerTestTemplate(t, tmpl)
+
+	opts := testProjectOptions("pack-app", "ourservice")
+	sink := NewMemorySink()
+	if err := Generate(context.Background(), opts, sink, nil); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	for _, name := range []string{"go.mod", "cmd/main.go", ".env", ManifestPath} {
+		if _, ok := sink.Files[name]; !ok {
+			t.Errorf("files should contain %s, got %v", name, sink.Paths())
+		}
+	}
+
+	opts.Features = []string{"metrics"}
+	if err := opts.Validate(); err == nil {
+		t.Error("a template pack should not accept optional features")
+	}
+
+	opts.Features = nil
+	opts.Variables = map[string]string{"team": "payments"}
+	sink = NewMemorySink()
+	if err := Generate(context.Background(), opts, sink, nil); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(sink.Files["cmd/main.go"].Content), "Owned by payments") {
+		t.Errorf("cmd/main.go = %s", sink.Files["cmd/main.go"].Content)
+	}
+}
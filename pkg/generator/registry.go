@@ -0,0 +1,270 @@
+package generator
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// Template constants define the available project generation templates.
+// - TemplateMinimal: Basic REST API with Swagger (no authentication)
+// - TemplateFull: Complete hexagonal architecture with JWT auth, user management (default)
+// - TemplateGraphQL: GraphQL API with gqlgen and GraphQL Playground (not yet implemented)
+const (
+	TemplateMinimal = "minimal"
+	TemplateFull    = "full"
+	TemplateGraphQL = "graphql"
+)
+
+// Template descriptions (in English for consistency with code)
+const (
+	TemplateMinimalDesc = "Basic REST API with Swagger (no authentication)"
+	TemplateFullDesc    = "Complete API with JWT auth, user management, and Swagger (default)"
+	TemplateGraphQLDesc = "GraphQL API with gqlgen and GraphQL Playground"
+)
+
+// DefaultTemplate is the default template type when not specified
+const DefaultTemplate = TemplateFull
+
+// Template describes a project template the generator can render.
+// Built-in templates are registered in this file; additional templates
+// (e.g. a team's internal template) only need to implement this interface
+// and call RegisterTemplate from an init function.
+type Template interface {
+	// Name identifies the template, as accepted by the CLI --template flag
+	Name() string
+	// Description is the one-line summary shown in the help output
+	Description() string
+	// Directories lists the directories to create, relative to the project root
+	Directories() []string
+	// Files returns the files to generate, with paths rooted at projectPath
+	Files(projectPath string, data TemplateData) ([]FileGenerator, error)
+	// PostGenerationSteps lists the actions run once all files are written
+	PostGenerationSteps() []PostGenerationStep
+}
+
+// PostGenerationStep is an action run on the generated files once they are
+// all rendered. Steps work on the files rather than on disk so that they
+// apply to every Sink.
+type PostGenerationStep struct {
+	// Name identifies the step (e.g. "copyEnvFile")
+	Name string
+	// Message is the progress line displayed before the step runs
+	Message string
+	// Run executes the step on the project files, keyed by their slash-separated
+	// path relative to the project root. Files it adds or changes are written
+	// after it. An error aborts project creation.
+	Run func(files map[string]string) error
+}
+
+// copyEnvStep copies .env.example to .env in the generated project
+var copyEnvStep = PostGenerationStep{
+	Name:    "copyEnvFile",
+	Message: "🔑 Configuring environment...",
+	Run:     copyEnvFile,
+}
+
+// copyEnvFile copies the generated .env.example to .env if .env doesn't already exist.
+func copyEnvFile(files map[string]string) error {
+	example, ok := files[".env.example"]
+	if !ok {
+		return fmt.Errorf(".env.example not found")
+	}
+	if _, ok := files[".env"]; ok {
+		// .env already exists, skip copying
+		return nil
+	}
+	files[".env"] = example
+	return nil
+}
+
+// registeredTemplates holds the templates in registration order
+var registeredTemplates []Template
+
+// RegisterTemplate adds a template to the registry.
+// It panics if the name is empty or already registered, as this is a programming error.
+func RegisterTemplate(t Template) {
+	if t.Name() == "" {
+		panic("template name cannot be empty")
+	}
+	if _, ok := LookupTemplate(t.Name()); ok {
+		panic(fmt.Sprintf("template '%s' is already registered", t.Name()))
+	}
+	registeredTemplates = append(registeredTemplates, t)
+}
+
+// LookupTemplate returns the registered template with the given name
+func LookupTemplate(name string) (Template, bool) {
+	for _, t := range registeredTemplates {
+		if t.Name() == name {
+			return t, true
+		}
+	}
+	return nil, false
+}
+
+// Templates returns all registered templates in registration order
+func Templates() []Template {
+	return append([]Template(nil), registeredTemplates...)
+}
+
+// ValidTemplates returns the names of all registered templates
+func ValidTemplates() []string {
+	names := make([]string, 0, len(registeredTemplates))
+	for _, t := range registeredTemplates {
+		names = append(names, t.Name())
+	}
+	return names
+}
+
+// ValidateTemplate checks if the template type is registered.
+// Built-in templates are: minimal, full, graphql
+func ValidateTemplate(template string) error {
+	if _, ok := LookupTemplate(template); ok {
+		return nil
+	}
+	return fmt.Errorf("invalid template '%s': valid options are: %s", template, strings.Join(ValidTemplates(), ", "))
+}
+
+// layeredTemplate is a Template whose files are rendered from layers of the
+// embedded template tree. Files of a later layer replace files with the same
+// path in an earlier one.
+type layeredTemplate struct {
+	name        string
+	description string
+	directories []string
+	layers      []string
+	steps       []PostGenerationStep
+}
+
+func (t *layeredTemplate) Name() string        { return t.name }
+func (t *layeredTemplate) Description() string { return t.description }
+
+func (t *layeredTemplate) Directories() []string {
+	return append([]string(nil), t.directories...)
+}
+
+func (t *layeredTemplate) PostGenerationSteps() []PostGenerationStep {
+	return append([]PostGenerationStep(nil), t.steps...)
+}
+
+// Files walks the template layers, followed by the layers of the HTTP router
+// overriding them, and renders every file
+func (t *layeredTemplate) Files(projectPath string, data TemplateData) ([]FileGenerator, error) {
+	fw, err := data.Framework()
+	if err != nil {
+		return nil, err
+	}
+	paths, contents, err := renderLayers(fw.overlay(t.layers), data)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]FileGenerator, 0, len(paths))
+	for _, p := range paths {
+		files = append(files, FileGenerator{
+			Path:    filepath.Join(projectPath, filepath.FromSlash(p)),
+			Content: contents[p],
+		})
+	}
+	return files, nil
+}
+
+// featureTemplate is a layeredTemplate optional features can be layered on.
+// The layers of the enabled features are rendered after the template layers.
+type featureTemplate struct {
+	*layeredTemplate
+	builtin []string
+}
+
+func (t *featureTemplate) BuiltinFeatures() []string {
+	return append([]string(nil), t.builtin...)
+}
+
+// Files renders the template layers followed by the layers of the built-in
+// features and of data.Features
+func (t *featureTemplate) Files(projectPath string, data TemplateData) ([]FileGenerator, error) {
+	features, err := resolveFeatureList(append(t.BuiltinFeatures(), data.Features...))
+	if err != nil {
+		return nil, err
+	}
+	data.Features = features
+
+	layered := *t.layeredTemplate
+	layered.layers = slices.Clone(t.layers)
+	for _, f := range enabledFeatures(data.Features) {
+		if layer := f.layer(); layer != "" {
+			layered.layers = append(layered.layers, layer)
+		}
+	}
+	return layered.Files(projectPath, data)
+}
+
+// commonDirs are the directories shared by all built-in templates
+var commonDirs = []string{
+	"cmd",
+	"internal/adapters/http",
+	"internal/infrastructure/database",
+	"internal/infrastructure/server",
+	"pkg/config",
+	"pkg/logger",
+	"docs",
+	"deployments",
+	".github/workflows",
+}
+
+// withCommonDirs returns commonDirs followed by the given directories
+func withCommonDirs(dirs ...string) []string {
+	return append(append([]string(nil), commonDirs...), dirs...)
+}
+
+func init() {
+	// Minimal template: only basic infrastructure, no auth
+	RegisterTemplate(&featureTemplate{
+		layeredTemplate: &layeredTemplate{
+			name:        TemplateMinimal,
+			description: TemplateMinimalDesc,
+			directories: withCommonDirs(),
+			layers:      []string{"common", "rest", "minimal"},
+			steps:       []PostGenerationStep{copyEnvStep},
+		},
+		builtin: []string{"swagger"},
+	})
+
+	// Full template: the REST base with auth and user management built in
+	RegisterTemplate(&featureTemplate{
+		layeredTemplate: &layeredTemplate{
+			name:        TemplateFull,
+			description: TemplateFullDesc,
+			directories: withCommonDirs(
+				"pkg/auth",
+				"internal/domain",
+				"internal/domain/user",
+				"internal/interfaces",
+				"internal/models",
+				"internal/adapters/middleware",
+				"internal/adapters/handlers",
+				"internal/adapters/repository",
+			),
+			layers: []string{"common", "rest", "full"},
+			steps:  []PostGenerationStep{copyEnvStep},
+		},
+		builtin: []string{"swagger", "auth", "users"},
+	})
+
+	// GraphQL template: includes graph directories for gqlgen
+	RegisterTemplate(&layeredTemplate{
+		name:        TemplateGraphQL,
+		description: TemplateGraphQLDesc,
+		directories: withCommonDirs(
+			"internal/interfaces",
+			"internal/models",
+			"graph",
+			"graph/model",
+			"graph/generated",
+		),
+		layers: []string{"common", "graphql"},
+		steps:  []PostGenerationStep{copyEnvStep},
+	})
+}
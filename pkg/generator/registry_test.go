@@ -0,0 +1,140 @@
+package generator
+
+import (
+	"context"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// fakeTemplate is a minimal Template implementation used to test the registry
+type fakeTemplate struct {
+	name string
+}
+
+func (f *fakeTemplate) Name() string          { return f.name }
+func (f *fakeTemplate) Description() string   { return "Fake template for tests" }
+func (f *fakeTemplate) Directories() []string { return []string{"cmd"} }
+
+func (f *fakeTemplate) Files(projectPath string, data TemplateData) ([]FileGenerator, error) {
+	return []FileGenerator{
+		{Path: filepath.Join(projectPath, "go.mod"), Content: "module " + data.ModulePath + "\n"},
+		{Path: filepath.Join(projectPath, "setup.sh"), Content: "#!/bin/sh\n"},
+	}, nil
+}
+
+func (f *fakeTemplate) PostGenerationSteps() []PostGenerationStep { return nil }
+
+// registerTestTemplate registers a template for the duration of the test
+func registerTestTemplate(t *testing.T, tmpl Template) {
+	t.Helper()
+	saved := registeredTemplates
+	t.Cleanup(func() { registeredTemplates = saved })
+	registeredTemplates = slices.Clone(registeredTemplates)
+	RegisterTemplate(tmpl)
+}
+
+// TestBuiltinTemplatesRegistered tests that built-in templates are registered in order
+func TestBuiltinTemplatesRegistered(t *testing.T) {
+	want := []string{TemplateMinimal, TemplateFull, TemplateGraphQL}
+	if got := ValidTemplates(); !slices.Equal(got, want) {
+		t.Errorf("ValidTemplates() = %v, want %v", got, want)
+	}
+
+	for _, name := range want {
+		tmpl, ok := LookupTemplate(name)
+		if !ok {
+			t.Fatalf("LookupTemplate(%q) not found", name)
+		}
+		if tmpl.Description() == "" {
+			t.Errorf("template %q should have a description", name)
+		}
+		if len(tmpl.PostGenerationSteps()) == 0 || tmpl.PostGenerationSteps()[0].Name != "copyEnvFile" {
+			t.Errorf("template %q should copy .env.example after generation", name)
+		}
+	}
+}
+
+// TestRegisterCustomTemplate tests that a registered template is used by every consumer
+func TestRegisterCustomTemplate(t *testing.T) {
+	registerTestTemplate(t, &fakeTemplate{name: "internal"})
+
+	if err := ValidateTemplate("internal"); err != nil {
+		t.Errorf("ValidateTemplate(internal) error = %v", err)
+	}
+	if err := ValidateTemplate("unknown"); err == nil || !strings.Contains(err.Error(), "graphql, internal") {
+		t.Errorf("validateTemplate error should list the custom template, got: %v", err)
+	}
+
+	sink := NewMemorySink()
+	if err := Generate(context.Background(), testProjectOptions("custom-app", "internal"), sink, nil); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if !slices.Equal(sink.Dirs, []string{"cmd"}) {
+		t.Errorf("dirs = %v, want the directories of the custom template", sink.Dirs)
+	}
+	if content := string(sink.Files["go.mod"].Content); content != "module custom-app\n" {
+		t.Errorf("go.mod should come from the custom template, got: %s", content)
+	}
+}
+
+// TestRegisterTemplateDuplicatePanics tests that a name can only be registered once
+func TestRegisterTemplateDuplicatePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("RegisterTemplate() should panic on a duplicate name")
+		}
+	}()
+	registerTestTemplate(t, &fakeTemplate{name: TemplateFull})
+}
+
+// TestValidateTemplateValid tests ValidateTemplate with valid templates (AC: 1, 2)
+func TestValidateTemplateValid(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+	}{
+		{"minimal template", "minimal"},
+		{"full template", "full"},
+		{"graphql template", "graphql"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTemplate(tt.template)
+			if err != nil {
+				t.Errorf("ValidateTemplate(%q) returned error: %v, want nil", tt.template, err)
+			}
+		})
+	}
+}
+
+// TestValidateTemplateInvalid tests ValidateTemplate with invalid templates (AC: 3)
+func TestValidateTemplateInvalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+	}{
+		{"empty template", ""},
+		{"unknown template", "unknown"},
+		{"invalid case", "FULL"},
+		{"invalid spacing", " full"},
+		{"invalid template", "invalid"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTemplate(tt.template)
+			if err == nil {
+				t.Errorf("ValidateTemplate(%q) returned nil, want error", tt.template)
+			}
+			if !strings.Contains(err.Error(), "invalid template") {
+				t.Errorf("ValidateTemplate(%q) error = %v, want error containing 'invalid template'", tt.template, err)
+			}
+			if !strings.Contains(err.Error(), "minimal, full, graphql") {
+				t.Errorf("ValidateTemplate(%q) error = %v, want error listing valid options", tt.template, err)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
-package main
+package generator
 
 import (
+	"context"
+	"errors"
 	"os"
 	"path/filepath"
 	"strings"
@@ -26,10 +28,9 @@ func TestCreateDirectories(t *testing.T) {
 		"deployments",
 	}
 
-	// Call the function to create directories (using full template)
-	err := createProjectStructure(projectPath, TemplateFull)
-	if err != nil {
-		t.Fatalf("createProjectStructure failed: %v", err)
+	// Create the project (using full template)
+	if err := Create(context.Background(), testProjectOptions(projectName, TemplateFull), projectPath, nil); err != nil {
+		t.Fatalf("Create() failed: %v", err)
 	}
 
 	// Verify each directory exists with correct permissions
@@ -62,25 +63,28 @@ func TestProjectAlreadyExists(t *testing.T) {
 		t.Fatalf("Failed to create test directory: %v", err)
 	}
 
-	// Try to create project structure - should return error
-	err = createProjectStructure(projectPath, TemplateFull)
-	if err == nil {
-		t.Error("Expected error when project directory already exists, got nil")
+	// Try to create the project - should return error
+	err = Create(context.Background(), testProjectOptions(projectName, TemplateFull), projectPath, nil)
+	if !errors.Is(err, ErrProjectExists) {
+		t.Fatalf("Expected ErrProjectExists when project directory already exists, got %v", err)
 	}
 	if !strings.Contains(err.Error(), "already exists") {
 		t.Errorf("Expected 'already exists' in error message, got: %v", err)
 	}
 }
 
-func TestCreateProjectStructureWithInvalidPath(t *testing.T) {
+func TestCreateWithNestedPath(t *testing.T) {
 	// Create a temp directory, then try to create a project in a non-existent subdirectory
 	tempDir := t.TempDir()
 	invalidPath := filepath.Join(tempDir, "nonexistent", "deeply", "nested", "project")
 
-	err := createProjectStructure(invalidPath, TemplateFull)
+	err := Create(context.Background(), testProjectOptions("project", TemplateFull), invalidPath, nil)
 	if err == nil {
 		t.Error("Expected error for invalid path, got nil")
 	}
+	if _, statErr := os.Stat(filepath.Join(tempDir, "nonexistent")); !os.IsNotExist(statErr) {
+		t.Error("a failed Create should leave nothing behind")
+	}
 }
 
 func TestValidateProjectName(t *testing.T) {
@@ -122,20 +126,12 @@ func TestValidateProjectName(t *testing.T) {
 	}
 }
 
-// TestCreateProjectStructureVerifiesAllDirectories verifies all directories are created correctly
-func TestCreateProjectStructureVerifiesAllDirectories(t *testing.T) {
-	tempDir := t.TempDir()
-	projectName := "verify-all-dirs"
-	projectPath := filepath.Join(tempDir, projectName)
-
-	// Call the function to create directories (using full template)
-	err := createProjectStructure(projectPath, TemplateFull)
-	if err != nil {
-		t.Fatalf("createProjectStructure failed: %v", err)
-	}
+// TestCreateVerifiesAllDirectories verifies all directories are created correctly
+func TestCreateVerifiesAllDirectories(t *testing.T) {
+	projectPath := writeTestProject(t, testProjectOptions("verify-all-dirs", TemplateFull))
 
 	// Complete list of all expected directories for full template
-	// (from getDirectoriesForTemplate(TemplateFull))
+	// (from the Directories of the full template)
 	allExpectedDirs := []string{
 		"cmd",
 		"internal/adapters/http",
@@ -171,16 +167,9 @@ func TestCreateProjectStructureVerifiesAllDirectories(t *testing.T) {
 	}
 }
 
-// TestCreateProjectStructureRootDirCreation tests root directory creation
-func TestCreateProjectStructureRootDirCreation(t *testing.T) {
-	tempDir := t.TempDir()
-	projectName := "root-test"
-	projectPath := filepath.Join(tempDir, projectName)
-
-	err := createProjectStructure(projectPath, TemplateFull)
-	if err != nil {
-		t.Fatalf("createProjectStructure failed: %v", err)
-	}
+// TestCreateRootDirCreation tests root directory creation
+func TestCreateRootDirCreation(t *testing.T) {
+	projectPath := writeTestProject(t, testProjectOptions("root-test", TemplateFull))
 
 	// Verify root directory exists with correct permissions
 	info, err := os.Stat(projectPath)
@@ -0,0 +1,192 @@
+package generator
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"time"
+)
+
+// Sink receives the directories and files of a generated project. Paths are
+// slash-separated and relative to the project root.
+type Sink interface {
+	// Mkdir creates a directory and any missing parent
+	Mkdir(dir string) error
+	// WriteFile writes a file with the given permissions, creating its
+	// directory if needed
+	WriteFile(name string, content []byte, perm fs.FileMode) error
+}
+
+// DirSink writes the project to a directory on disk
+type DirSink struct {
+	root string
+}
+
+// NewDirSink returns a Sink writing the project into root, which is created
+// if it does not exist
+func NewDirSink(root string) *DirSink {
+	return &DirSink{root: root}
+}
+
+// Mkdir creates dir in the project directory
+func (s *DirSink) Mkdir(dir string) error {
+	if err := os.MkdirAll(filepath.Join(s.root, filepath.FromSlash(dir)), defaultDirPerm); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", dir, err)
+	}
+	return nil
+}
+
+// WriteFile writes name in the project directory
+func (s *DirSink) WriteFile(name string, content []byte, perm fs.FileMode) error {
+	fullPath := filepath.Join(s.root, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(fullPath), defaultDirPerm); err != nil {
+		return fmt.Errorf("failed to create directory for %s: %w", fullPath, err)
+	}
+	if err := os.WriteFile(fullPath, content, perm); err != nil {
+		return fmt.Errorf("failed to write file %s: %w", fullPath, err)
+	}
+	// WriteFile leaves the permissions of an existing file alone
+	if err := os.Chmod(fullPath, perm); err != nil {
+		return fmt.Errorf("failed to set permissions of %s: %w", fullPath, err)
+	}
+	return nil
+}
+
+// MemoryFile is a file held by a MemorySink
+type MemoryFile struct {
+	Content []byte
+	Mode    fs.FileMode
+}
+
+// MemorySink keeps the project in memory
+type MemorySink struct {
+	// Dirs lists the created directories, in creation order
+	Dirs []string
+	// Files maps the path of each written file to its content
+	Files map[string]MemoryFile
+}
+
+// NewMemorySink returns an empty MemorySink
+func NewMemorySink() *MemorySink {
+	return &MemorySink{Files: make(map[string]MemoryFile)}
+}
+
+// Mkdir records dir
+func (s *MemorySink) Mkdir(dir string) error {
+	if !slices.Contains(s.Dirs, dir) {
+		s.Dirs = append(s.Dirs, dir)
+	}
+	return nil
+}
+
+// WriteFile records name, replacing a previous file with the same path
+func (s *MemorySink) WriteFile(name string, content []byte, perm fs.FileMode) error {
+	s.Files[name] = MemoryFile{Content: slices.Clone(content), Mode: perm}
+	return nil
+}
+
+// Paths returns the paths of the files, sorted
+func (s *MemorySink) Paths() []string {
+	paths := make([]string, 0, len(s.Files))
+	for p := range s.Files {
+		paths = append(paths, p)
+	}
+	slices.Sort(paths)
+	return paths
+}
+
+// TarSink streams the project as a gzip-compressed tar archive. Close must be
+// called to complete the archive.
+type TarSink struct {
+	prefix  string
+	modTime time.Time
+	gz      *gzip.Writer
+	tw      *tar.Writer
+}
+
+// NewTarSink returns a Sink writing a .tar.gz archive to w, with every entry
+// under the prefix directory (usually the project name; empty for none)
+func NewTarSink(w io.Writer, prefix string) *TarSink {
+	gz := gzip.NewWriter(w)
+	return &TarSink{prefix: prefix, modTime: time.Now(), gz: gz, tw: tar.NewWriter(gz)}
+}
+
+// Mkdir adds a directory entry
+func (s *TarSink) Mkdir(dir string) error {
+	return s.tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeDir,
+		Name:     path.Join(s.prefix, dir) + "/",
+		Mode:     int64(defaultDirPerm),
+		ModTime:  s.modTime,
+	})
+}
+
+// WriteFile adds a file entry
+func (s *TarSink) WriteFile(name string, content []byte, perm fs.FileMode) error {
+	err := s.tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     path.Join(s.prefix, name),
+		Mode:     int64(perm),
+		Size:     int64(len(content)),
+		ModTime:  s.modTime,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = s.tw.Write(content)
+	return err
+}
+
+// Close completes the archive. It does not close the underlying writer.
+func (s *TarSink) Close() error {
+	if err := s.tw.Close(); err != nil {
+		return err
+	}
+	return s.gz.Close()
+}
+
+// ZipSink streams the project as a zip archive. Close must be called to
+// complete the archive.
+type ZipSink struct {
+	prefix  string
+	modTime time.Time
+	zw      *zip.Writer
+}
+
+// NewZipSink returns a Sink writing a zip archive to w, with every entry under
+// the prefix directory (usually the project name; empty for none)
+func NewZipSink(w io.Writer, prefix string) *ZipSink {
+	return &ZipSink{prefix: prefix, modTime: time.Now(), zw: zip.NewWriter(w)}
+}
+
+// Mkdir adds a directory entry
+func (s *ZipSink) Mkdir(dir string) error {
+	header := &zip.FileHeader{Name: path.Join(s.prefix, dir) + "/", Modified: s.modTime}
+	header.SetMode(fs.ModeDir | defaultDirPerm)
+	_, err := s.zw.CreateHeader(header)
+	return err
+}
+
+// WriteFile adds a compressed file entry
+func (s *ZipSink) WriteFile(name string, content []byte, perm fs.FileMode) error {
+	header := &zip.FileHeader{Name: path.Join(s.prefix, name), Method: zip.Deflate, Modified: s.modTime}
+	header.SetMode(perm)
+	w, err := s.zw.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(content)
+	return err
+}
+
+// Close completes the archive. It does not close the underlying writer.
+func (s *ZipSink) Close() error {
+	return s.zw.Close()
+}
@@ -0,0 +1,215 @@
+package generator
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestGenerateMemorySink(t *testing.T) {
+	sink := NewMemorySink()
+	var events []Event
+	err := Generate(context.Background(), testProjectOptions("memory-app", TemplateMinimal), sink, func(e Event) {
+		events = append(events, e)
+	})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	if !slices.Contains(sink.Dirs, "internal/adapters/http") {
+		t.Errorf("dirs = %v, want internal/adapters/http", sink.Dirs)
+	}
+	for _, name := range []string{"go.mod", ".env.example", ".env", ManifestPath} {
+		if _, ok := sink.Files[name]; !ok {
+			t.Errorf("files should contain %s, got %v", name, sink.Paths())
+		}
+	}
+	if !strings.HasPrefix(string(sink.Files["go.mod"].Content), "module memory-app") {
+		t.Errorf("go.mod = %q", sink.Files["go.mod"].Content)
+	}
+	if mode := sink.Files["setup.sh"].Mode; mode != 0755 {
+		t.Errorf("setup.sh mode = %v, want 0755", mode)
+	}
+
+	// Each stage starts with an event and ends with a done event
+	var stages []Stage
+	for _, e := range events {
+		if e.Done {
+			stages = append(stages, e.Stage)
+		}
+	}
+	if want := []Stage{StageDirectories, StageFiles, StageStep}; !slices.Equal(stages, want) {
+		t.Errorf("done stages = %v, want %v", stages, want)
+	}
+	if events[0] != (Event{Stage: StageDirectories}) {
+		t.Errorf("first event = %+v", events[0])
+	}
+	written := slices.IndexFunc(events, func(e Event) bool { return e.Stage == StageStep && e.Path == ".env" })
+	if written < 0 {
+		t.Error("the .env step should report the file it writes")
+	}
+}
+
+func TestGenerateInvalidOptions(t *testing.T) {
+	sink := NewMemorySink()
+	err := Generate(context.Background(), testProjectOptions("invalid name!", TemplateMinimal), sink, nil)
+	if !errors.Is(err, ErrInvalidOptions) {
+		t.Errorf("Generate() error = %v, want ErrInvalidOptions", err)
+	}
+	if len(sink.Files) != 0 {
+		t.Errorf("invalid options should write nothing, got %v", sink.Paths())
+	}
+}
+
+func TestGenerateCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := Generate(ctx, testProjectOptions("cancelled-app", TemplateMinimal), NewMemorySink(), nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Generate() error = %v, want context.Canceled", err)
+	}
+}
+
+// failingSink fails every write
+type failingSink struct{}
+
+func (failingSink) Mkdir(string) error { return nil }
+func (failingSink) WriteFile(string, []byte, os.FileMode) error {
+	return errors.New("disk full")
+}
+
+func TestGenerateWriteError(t *testing.T) {
+	err := Generate(context.Background(), testProjectOptions("full-disk", TemplateMinimal), failingSink{}, nil)
+	if !errors.Is(err, ErrWrite) || !strings.Contains(err.Error(), "disk full") {
+		t.Errorf("Generate() error = %v, want ErrWrite with the sink error", err)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "created-app")
+	if err := Create(context.Background(), testProjectOptions("created-app", TemplateMinimal), dir, nil); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	info, err := os.Stat(filepath.Join(dir, "setup.sh"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0755 {
+		t.Errorf("setup.sh mode = %v, want 0755", info.Mode().Perm())
+	}
+	if _, err := os.Stat(filepath.Join(dir, ".env")); err != nil {
+		t.Errorf("Create() should copy .env: %v", err)
+	}
+
+	err = Create(context.Background(), testProjectOptions("created-app", TemplateMinimal), dir, nil)
+	if !errors.Is(err, ErrProjectExists) {
+		t.Errorf("second Create() error = %v, want ErrProjectExists", err)
+	}
+}
+
+// TestArchiveSinks tests that the archive sinks hold the same files as a MemorySink
+func TestArchiveSinks(t *testing.T) {
+	// Every generation gets the same manifest timestamp
+	t.Setenv("SOURCE_DATE_EPOCH", "1700000000")
+	opts := testProjectOptions("archive-app", TemplateMinimal)
+	want := NewMemorySink()
+	if err := Generate(context.Background(), opts, want, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("tar", func(t *testing.T) {
+		var buf bytes.Buffer
+		sink := NewTarSink(&buf, "archive-app")
+		if err := Generate(context.Background(), opts, sink, nil); err != nil {
+			t.Fatal(err)
+		}
+		if err := sink.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		gz, err := gzip.NewReader(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tr := tar.NewReader(gz)
+		got := NewMemorySink()
+		for {
+			header, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if header.Typeflag != tar.TypeReg {
+				continue
+			}
+			content, err := io.ReadAll(tr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			name, ok := strings.CutPrefix(header.Name, "archive-app/")
+			if !ok {
+				t.Errorf("entry %s is outside the prefix directory", header.Name)
+			}
+			got.WriteFile(name, content, os.FileMode(header.Mode))
+		}
+		compareSinks(t, got, want)
+	})
+
+	t.Run("zip", func(t *testing.T) {
+		var buf bytes.Buffer
+		sink := NewZipSink(&buf, "archive-app")
+		if err := Generate(context.Background(), opts, sink, nil); err != nil {
+			t.Fatal(err)
+		}
+		if err := sink.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := NewMemorySink()
+		for _, f := range zr.File {
+			if f.FileInfo().IsDir() {
+				continue
+			}
+			rc, err := f.Open()
+			if err != nil {
+				t.Fatal(err)
+			}
+			content, err := io.ReadAll(rc)
+			rc.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+			got.WriteFile(strings.TrimPrefix(f.Name, "archive-app/"), content, f.Mode().Perm())
+		}
+		compareSinks(t, got, want)
+	})
+}
+
+// compareSinks reports the files of got that differ from want
+func compareSinks(t *testing.T, got, want *MemorySink) {
+	t.Helper()
+	if !slices.Equal(got.Paths(), want.Paths()) {
+		t.Fatalf("paths = %v, want %v", got.Paths(), want.Paths())
+	}
+	for name, file := range want.Files {
+		g := got.Files[name]
+		if !bytes.Equal(g.Content, file.Content) || g.Mode != file.Mode {
+			t.Errorf("%s differs: mode %v, want %v", name, g.Mode, file.Mode)
+		}
+	}
+}
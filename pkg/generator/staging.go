@@ -0,0 +1,50 @@
+package generator
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// stagingDir is a temporary directory created next to the target project
+// directory. The project is generated inside it and moved into place with a
+// single rename, so a failed or interrupted generation leaves nothing behind.
+type stagingDir struct {
+	// root is the temporary directory, removed by cleanup
+	root string
+	// projectPath is where the project is generated inside root
+	projectPath string
+	// target is the final project directory
+	target string
+}
+
+// newStagingDir creates a staging directory in the parent of target.
+// Staying on the same filesystem keeps the final rename atomic.
+func newStagingDir(target string) (*stagingDir, error) {
+	root, err := os.MkdirTemp(filepath.Dir(target), "."+filepath.Base(target)+".staging-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create staging directory: %w", err)
+	}
+	return &stagingDir{
+		root:        root,
+		projectPath: filepath.Join(root, filepath.Base(target)),
+		target:      target,
+	}, nil
+}
+
+// commit moves the generated project to its final location
+func (s *stagingDir) commit() error {
+	if err := checkProjectPathAvailable(s.target); err != nil {
+		return err
+	}
+	if err := os.Rename(s.projectPath, s.target); err != nil {
+		return withKind(ErrWrite, fmt.Errorf("failed to move project into place: %w", err))
+	}
+	return nil
+}
+
+// cleanup removes the staging directory and anything left in it.
+// It is safe to call after commit.
+func (s *stagingDir) cleanup() {
+	os.RemoveAll(s.root)
+}
@@ -0,0 +1,106 @@
+package generator
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// failingTemplate is a template whose post-generation step always fails
+type failingTemplate struct {
+	fakeTemplate
+}
+
+func (f *failingTemplate) PostGenerationSteps() []PostGenerationStep {
+	return []PostGenerationStep{{
+		Name:    "fail",
+		Message: "Failing on purpose...",
+		Run: func(files map[string]string) error {
+			return errors.New("post-generation step failed")
+		},
+	}}
+}
+
+func TestStagingDirCommit(t *testing.T) {
+	target := filepath.Join(t.TempDir(), "staged-app")
+
+	staging, err := newStagingDir(target)
+	if err != nil {
+		t.Fatalf("newStagingDir() error = %v", err)
+	}
+	defer staging.cleanup()
+
+	if filepath.Dir(staging.root) != filepath.Dir(target) {
+		t.Errorf("staging directory %s should be created next to %s", staging.root, target)
+	}
+
+	if err := os.MkdirAll(staging.projectPath, defaultDirPerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(staging.projectPath, "go.mod"), []byte("module staged-app\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := staging.commit(); err != nil {
+		t.Fatalf("commit() error = %v", err)
+	}
+	staging.cleanup()
+
+	if _, err := os.Stat(filepath.Join(target, "go.mod")); err != nil {
+		t.Errorf("committed project should contain go.mod: %v", err)
+	}
+	if _, err := os.Stat(staging.root); !os.IsNotExist(err) {
+		t.Error("cleanup() should remove the staging directory")
+	}
+}
+
+func TestStagingDirCommitExistingTarget(t *testing.T) {
+	target := filepath.Join(t.TempDir(), "taken-app")
+	if err := os.Mkdir(target, defaultDirPerm); err != nil {
+		t.Fatal(err)
+	}
+
+	staging, err := newStagingDir(target)
+	if err != nil {
+		t.Fatalf("newStagingDir() error = %v", err)
+	}
+	defer staging.cleanup()
+
+	if err := os.MkdirAll(staging.projectPath, defaultDirPerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := staging.commit(); err == nil {
+		t.Error("commit() should fail when the target directory already exists")
+	}
+}
+
+// TestCreateRollbackOnFailure tests that a failing generation leaves nothing behind
+func TestCreateRollbackOnFailure(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+	registerTestTemplate(t, &failingTemplate{fakeTemplate{name: "failing"}})
+
+	opts := DefaultProjectOptions()
+	opts.ProjectName = "rollback-app"
+	opts.Template = "failing"
+	err := Create(context.Background(), opts, "rollback-app", nil)
+	if err == nil {
+		t.Fatal("Create() should return the post-generation step error")
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, entry := range entries {
+		t.Errorf("failed generation left %s behind", entry.Name())
+	}
+
+	// A second attempt must not fail with "directory already exists"
+	opts.Template = TemplateMinimal
+	if err := Create(context.Background(), opts, "rollback-app", nil); err != nil {
+		t.Errorf("Create() after a failed attempt error = %v", err)
+	}
+}
@@ -1,4 +1,4 @@
-package main
+package generator
 
 import (
 	"os"
@@ -274,19 +274,8 @@ func TestMinimalReadmeTemplate(t *testing.T) {
 
 // TestGenerateMinimalProjectFiles tests that minimal template generates correct files (AC: 1, 2, 3)
 func TestGenerateMinimalProjectFiles(t *testing.T) {
-	tempDir := t.TempDir()
 	projectName := "minimal-project"
-	projectPath := filepath.Join(tempDir, projectName)
-
-	// Create the project structure first (using minimal template directories)
-	if err := createProjectStructure(projectPath, TemplateMinimal); err != nil {
-		t.Fatalf("Failed to create project structure: %v", err)
-	}
-
-	// Generate project files with minimal template
-	if err := generateProjectFiles(projectPath, projectName, TemplateMinimal); err != nil {
-		t.Fatalf("generateProjectFiles() with minimal template error = %v", err)
-	}
+	projectPath := writeTestProject(t, testProjectOptions(projectName, TemplateMinimal))
 
 	// Expected files for minimal template
 	expectedFiles := []string{
@@ -349,19 +338,8 @@ func TestE2EMinimalProjectBuilds(t *testing.T) {
 		t.Skip("Skipping E2E test in short mode")
 	}
 
-	tempDir := t.TempDir()
 	projectName := "e2e-minimal-project"
-	projectPath := filepath.Join(tempDir, projectName)
-
-	// Create the project structure (using minimal template directories)
-	if err := createProjectStructure(projectPath, TemplateMinimal); err != nil {
-		t.Fatalf("Failed to create project structure: %v", err)
-	}
-
-	// Generate project files with minimal template
-	if err := generateProjectFiles(projectPath, projectName, TemplateMinimal); err != nil {
-		t.Fatalf("Failed to generate project files with minimal template: %v", err)
-	}
+	projectPath := writeTestProject(t, testProjectOptions(projectName, TemplateMinimal))
 
 	// Run go mod tidy
 	t.Run("GoModTidy", func(t *testing.T) {
@@ -410,19 +388,8 @@ func TestE2EMinimalProjectBuilds(t *testing.T) {
 
 // TestMinimalTemplateNoAuthFiles verifies no auth-related files exist (AC: 2)
 func TestMinimalTemplateNoAuthFiles(t *testing.T) {
-	tempDir := t.TempDir()
 	projectName := "no-auth-check"
-	projectPath := filepath.Join(tempDir, projectName)
-
-	// Create the project structure (using minimal template directories)
-	if err := createProjectStructure(projectPath, TemplateMinimal); err != nil {
-		t.Fatalf("Failed to create project structure: %v", err)
-	}
-
-	// Generate project files with minimal template
-	if err := generateProjectFiles(projectPath, projectName, TemplateMinimal); err != nil {
-		t.Fatalf("generateProjectFiles() with minimal template error = %v", err)
-	}
+	projectPath := writeTestProject(t, testProjectOptions(projectName, TemplateMinimal))
 
 	// Read main.go and verify no auth imports
 	mainGoPath := filepath.Join(projectPath, "cmd", "main.go")
@@ -462,7 +429,7 @@ func TestMinimalTemplateNoAuthFiles(t *testing.T) {
 
 // TestGetDirectoriesForMinimalTemplate tests that minimal template has correct directories
 func TestGetDirectoriesForMinimalTemplate(t *testing.T) {
-	dirs := getDirectoriesForTemplate(TemplateMinimal)
+	dirs := generatedDirs(t, TemplateMinimal)
 
 	// Required directories for minimal
 	requiredDirs := []string{
@@ -484,7 +451,7 @@ func TestGetDirectoriesForMinimalTemplate(t *testing.T) {
 			}
 		}
 		if !found {
-			t.Errorf("getDirectoriesForTemplate(minimal) should include '%s'", dir)
+			t.Errorf("minimal template directories should include '%s'", dir)
 		}
 	}
 
@@ -502,7 +469,7 @@ func TestGetDirectoriesForMinimalTemplate(t *testing.T) {
 	for _, dir := range excludedDirs {
 		for _, d := range dirs {
 			if d == dir {
-				t.Errorf("getDirectoriesForTemplate(minimal) should NOT include '%s'", dir)
+				t.Errorf("minimal template directories should NOT include '%s'", dir)
 			}
 		}
 	}
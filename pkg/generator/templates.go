@@ -0,0 +1,310 @@
+package generator
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+)
+
+// templateFS holds the template tree. Each top-level directory is a layer
+// (common, rest, full, minimal, graphql) whose files mirror the layout of the
+// generated project, with a .tmpl suffix added to every file name.
+// features/<name> holds one layer per optional feature, and partials holds
+// snippets included by other files rather than generated. model holds the
+// files of a resource added by add-model, "model" in their paths standing for
+// the resource name. githooks holds the git hooks installed with
+// GitOptions.InstallHooks.
+//
+//go:embed all:templates
+var templateFS embed.FS
+
+// templateRoot is the directory of templateFS containing the layers
+const templateRoot = "templates"
+
+// templateExt is the suffix stripped from template files when they are written
+const templateExt = ".tmpl"
+
+// TemplateData is the context every template file is rendered against
+type TemplateData struct {
+	// ProjectName is used for the project directory, binary and display names
+	ProjectName string
+	// ModulePath is the Go module path written to go.mod and used in imports
+	ModulePath string
+	// Features lists the optional features enabled for the project
+	Features []string
+	// DBDriver is the database driver used by the generated project
+	DBDriver string
+	// HTTPFramework is the HTTP router the server and handlers are written for
+	HTTPFramework string
+	// CIProvider is the CI system the workflow files are generated for ("github" or "none")
+	CIProvider string
+	// License is the license of the generated project ("MIT", or empty for none)
+	License string
+	// Year is the generation year, used in the license
+	Year int
+	// Model is the resource rendered by add-model (nil when generating a project)
+	Model *Model
+	// Vars holds the template variables: ProjectOptions.Variables, on top of the
+	// defaults declared by a template pack
+	Vars map[string]string
+}
+
+// HasFeature reports whether the named feature is enabled
+func (d TemplateData) HasFeature(name string) bool {
+	return slices.Contains(d.Features, name)
+}
+
+// DefaultDBDriver is the database driver used when none is specified
+const DefaultDBDriver = "postgres"
+
+// DefaultFramework is the HTTP router used when none is specified
+const DefaultFramework = "fiber"
+
+// DefaultCIProvider is the CI provider used when none is specified
+const DefaultCIProvider = "github"
+
+// newTemplateData builds the default template context for a project name
+func newTemplateData(projectName string) TemplateData {
+	return TemplateData{
+		ProjectName:   projectName,
+		ModulePath:    projectName,
+		DBDriver:      DefaultDBDriver,
+		HTTPFramework: DefaultFramework,
+		CIProvider:    DefaultCIProvider,
+		Year:          time.Now().Year(),
+	}
+}
+
+// parsedTemplates parses the whole template tree once. Every file is registered
+// under its path relative to templateRoot (e.g. "full/cmd/main.go.tmpl") so layers
+// can include each other with {{template "common/..." .}}.
+var parsedTemplates = sync.OnceValues(func() (*template.Template, error) {
+	root := template.New(templateRoot).Option("missingkey=error")
+	err := fs.WalkDir(templateFS, templateRoot, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		content, err := fs.ReadFile(templateFS, p)
+		if err != nil {
+			return err
+		}
+		name := strings.TrimPrefix(p, templateRoot+"/")
+		if _, err := root.New(name).Parse(string(content)); err != nil {
+			return fmt.Errorf("failed to parse template %s: %w", name, err)
+		}
+		return nil
+	})
+	return root, err
+})
+
+// renderTemplate executes the named file of the template tree with the given data
+func renderTemplate(name string, data TemplateData) (string, error) {
+	root, err := parsedTemplates()
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := root.ExecuteTemplate(&buf, name, data); err != nil {
+		return "", fmt.Errorf("failed to render template %s: %w", name, err)
+	}
+	return buf.String(), nil
+}
+
+// renderLayers renders every file of the given layers, in order. Files of a later
+// layer replace files with the same path in an earlier one. A file that renders
+// to whitespace only is not generated, so a template can make a whole file
+// conditional with {{if}}. Go files are formatted with formatGoSource. The
+// returned paths are relative to the project root,
+// slash-separated and sorted.
+func renderLayers(layers []string, data TemplateData) ([]string, map[string]string, error) {
+	contents := make(map[string]string)
+	for _, layer := range layers {
+		layerDir := path.Join(templateRoot, layer)
+		err := fs.WalkDir(templateFS, layerDir, func(p string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			rel := strings.TrimPrefix(p, layerDir+"/")
+			content, err := renderTemplate(path.Join(layer, rel), data)
+			if err != nil {
+				return err
+			}
+			target := strings.TrimSuffix(rel, templateExt)
+			if strings.TrimSpace(content) == "" {
+				delete(contents, target)
+				return nil
+			}
+			if isGoFile(target) {
+				if content, err = formatGoSource(path.Join(layer, rel), data.ModulePath, content); err != nil {
+					return err
+				}
+			}
+			contents[target] = content
+			return nil
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to render layer %s: %w", layer, err)
+		}
+	}
+
+	paths := make([]string, 0, len(contents))
+	for p := range contents {
+		paths = append(paths, p)
+	}
+	slices.Sort(paths)
+	return paths, contents, nil
+}
+
+// ProjectTemplates holds all the templates for project file generation
+type ProjectTemplates struct {
+	projectName string
+	data        TemplateData
+}
+
+// NewProjectTemplates creates a new templates instance with the given project name
+func NewProjectTemplates(projectName string) *ProjectTemplates {
+	return &ProjectTemplates{
+		projectName: projectName,
+		data:        newTemplateData(projectName),
+	}
+}
+
+// render executes a single file of the template tree with the features built
+// into the full template. See renderFor.
+func (t *ProjectTemplates) render(name string) string {
+	return t.renderFor(TemplateFull, name)
+}
+
+// renderFor executes a single file of the template tree with the features built
+// into the given template, formatting Go files like renderLayers. The tree is
+// embedded and covered by tests, so a failure here is a programming error.
+func (t *ProjectTemplates) renderFor(template, name string) string {
+	data := t.data
+	features, err := ResolveFeatures(template, nil)
+	if err != nil {
+		panic(err)
+	}
+	data.Features = features
+
+	content, err := renderTemplate(name, data)
+	if err != nil {
+		panic(err)
+	}
+	if isGoFile(strings.TrimSuffix(name, templateExt)) {
+		if content, err = formatGoSource(name, data.ModulePath, content); err != nil {
+			panic(err)
+		}
+	}
+	return content
+}
+
+// GoModTemplate returns the go.mod file content
+func (t *ProjectTemplates) GoModTemplate() string {
+	return t.render("rest/go.mod.tmpl")
+}
+
+// MainGoTemplate returns the main.go file content
+func (t *ProjectTemplates) MainGoTemplate() string {
+	return t.render("legacy/cmd/main.go.tmpl")
+}
+
+// DockerfileTemplate returns the Dockerfile content
+// Optimized for minimal image size (<50MB) with security best practices
+func (t *ProjectTemplates) DockerfileTemplate() string {
+	return t.render("common/Dockerfile.tmpl")
+}
+
+// GolangCILintTemplate returns the .golangci.yml file content
+// Compatible with golangci-lint v1.x (widely deployed)
+func (t *ProjectTemplates) GolangCILintTemplate() string {
+	return t.render("common/.golangci.yml.tmpl")
+}
+
+// MakefileTemplate returns the Makefile content
+func (t *ProjectTemplates) MakefileTemplate() string {
+	return t.render("rest/Makefile.tmpl")
+}
+
+// EnvTemplate returns the .env.example file content
+func (t *ProjectTemplates) EnvTemplate() string {
+	return t.render("full/.env.example.tmpl")
+}
+
+// GitignoreTemplate returns the .gitignore file content
+func (t *ProjectTemplates) GitignoreTemplate() string {
+	return t.render("common/.gitignore.tmpl")
+}
+
+// DockerComposeTemplate returns the docker-compose.yml file content
+func (t *ProjectTemplates) DockerComposeTemplate() string {
+	return t.render("full/docker-compose.yml.tmpl")
+}
+
+// ReadmeTemplate returns the README.md file content
+func (t *ProjectTemplates) ReadmeTemplate() string {
+	return t.render("full/README.md.tmpl")
+}
+
+// LoggerTemplate returns the pkg/logger/logger.go file content
+func (t *ProjectTemplates) LoggerTemplate() string {
+	return t.render("common/pkg/logger/logger.go.tmpl")
+}
+
+// DatabaseTemplate returns the internal/infrastructure/database/database.go file content
+func (t *ProjectTemplates) DatabaseTemplate() string {
+	return t.render("full/internal/infrastructure/database/database.go.tmpl")
+}
+
+// ServerTemplate returns the internal/infrastructure/server/server.go file content
+func (t *ProjectTemplates) ServerTemplate() string {
+	return t.render("full/internal/infrastructure/server/server.go.tmpl")
+}
+
+// HealthHandlerTemplate returns the internal/adapters/http/health.go file content
+func (t *ProjectTemplates) HealthHandlerTemplate() string {
+	return t.render("rest/internal/adapters/http/health.go.tmpl")
+}
+
+// ConfigTemplate returns the pkg/config/env.go file content
+func (t *ProjectTemplates) ConfigTemplate() string {
+	return t.render("common/pkg/config/env.go.tmpl")
+}
+
+// UpdatedMainGoTemplate returns the updated cmd/main.go file content with fx integration
+func (t *ProjectTemplates) UpdatedMainGoTemplate() string {
+	return t.render("full/cmd/main.go.tmpl")
+}
+
+// GitHubActionsWorkflowTemplate returns the .github/workflows/ci.yml file content
+func (t *ProjectTemplates) GitHubActionsWorkflowTemplate() string {
+	return t.render("common/.github/workflows/ci.yml.tmpl")
+}
+
+// DocsReadmeTemplate returns the docs/README.md file content (navigation hub)
+func (t *ProjectTemplates) DocsReadmeTemplate() string {
+	return t.render("full/docs/README.md.tmpl")
+}
+
+// SwaggerDocsTemplate returns a placeholder docs/docs.go file
+// This file will be overwritten when running 'make swagger' (swag init)
+// but is needed for the project to compile before swagger generation
+func (t *ProjectTemplates) SwaggerDocsTemplate() string {
+	return t.render("rest/docs/docs.go.tmpl")
+}
+
+// QuickStartTemplate returns the docs/quick-start.md file content
+func (t *ProjectTemplates) QuickStartTemplate() string {
+	return t.render("full/docs/quick-start.md.tmpl")
+}
+
+// SetupScriptTemplate returns the setup.sh file content for automated project setup
+func (t *ProjectTemplates) SetupScriptTemplate() string {
+	return t.render("full/setup.sh.tmpl")
+}
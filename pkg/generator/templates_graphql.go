@@ -0,0 +1,113 @@
+package generator
+
+// GraphQLGoModTemplate returns the go.mod file content for GraphQL template.
+// Includes gqlgen and gofiber/adaptor for net/http handler integration.
+func (t *ProjectTemplates) GraphQLGoModTemplate() string {
+	return t.render("graphql/go.mod.tmpl")
+}
+
+// GraphQLMainGoTemplate returns the cmd/main.go file content for GraphQL template.
+func (t *ProjectTemplates) GraphQLMainGoTemplate() string {
+	return t.render("graphql/cmd/main.go.tmpl")
+}
+
+// GqlGenYmlTemplate returns the gqlgen.yml configuration file content.
+func (t *ProjectTemplates) GqlGenYmlTemplate() string {
+	return t.render("graphql/gqlgen.yml.tmpl")
+}
+
+// GraphQLSchemaTemplate returns the GraphQL schema file content.
+func (t *ProjectTemplates) GraphQLSchemaTemplate() string {
+	return t.render("graphql/graph/schema.graphqls.tmpl")
+}
+
+// GraphQLResolverTemplate returns the resolver.go file content.
+func (t *ProjectTemplates) GraphQLResolverTemplate() string {
+	return t.render("graphql/graph/resolver.go.tmpl")
+}
+
+// GraphQLSchemaResolversTemplate returns the schema.resolvers.go file content.
+func (t *ProjectTemplates) GraphQLSchemaResolversTemplate() string {
+	return t.render("graphql/graph/schema.resolvers.go.tmpl")
+}
+
+// GraphQLModelTemplate returns the graph/model/models.go file content.
+func (t *ProjectTemplates) GraphQLModelTemplate() string {
+	return t.render("graphql/graph/model/models.go.tmpl")
+}
+
+// GraphQLGeneratedTemplate returns a placeholder for graph/generated/generated.go.
+// This file will be overwritten when running 'go generate ./...' or 'go run github.com/99designs/gqlgen generate'
+func (t *ProjectTemplates) GraphQLGeneratedTemplate() string {
+	return t.render("graphql/graph/generated/generated.go.tmpl")
+}
+
+// GraphQLServerTemplate returns the internal/infrastructure/server/server.go for GraphQL template.
+func (t *ProjectTemplates) GraphQLServerTemplate() string {
+	return t.render("graphql/internal/infrastructure/server/server.go.tmpl")
+}
+
+// GraphQLDatabaseTemplate returns the internal/infrastructure/database/database.go for GraphQL template.
+func (t *ProjectTemplates) GraphQLDatabaseTemplate() string {
+	return t.render("graphql/internal/infrastructure/database/database.go.tmpl")
+}
+
+// GraphQLUserRepositoryTemplate returns the user repository for GraphQL template.
+func (t *ProjectTemplates) GraphQLUserRepositoryTemplate() string {
+	return t.render("graphql/internal/infrastructure/database/user_repository.go.tmpl")
+}
+
+// GraphQLModelsUserTemplate returns the internal/models/user.go for GraphQL template.
+func (t *ProjectTemplates) GraphQLModelsUserTemplate() string {
+	return t.render("graphql/internal/models/user.go.tmpl")
+}
+
+// GraphQLInterfacesTemplate returns the internal/interfaces/user_repository.go for GraphQL template.
+func (t *ProjectTemplates) GraphQLInterfacesTemplate() string {
+	return t.render("graphql/internal/interfaces/user_repository.go.tmpl")
+}
+
+// GraphQLEnvTemplate returns the .env.example file content for GraphQL template.
+func (t *ProjectTemplates) GraphQLEnvTemplate() string {
+	return t.render("graphql/.env.example.tmpl")
+}
+
+// GraphQLReadmeTemplate returns the README.md file content for GraphQL template.
+func (t *ProjectTemplates) GraphQLReadmeTemplate() string {
+	return t.render("graphql/README.md.tmpl")
+}
+
+// GraphQLMakefileTemplate returns the Makefile for GraphQL template.
+func (t *ProjectTemplates) GraphQLMakefileTemplate() string {
+	return t.render("graphql/Makefile.tmpl")
+}
+
+// GraphQLSetupScriptTemplate returns the setup.sh for GraphQL template.
+func (t *ProjectTemplates) GraphQLSetupScriptTemplate() string {
+	return t.render("graphql/setup.sh.tmpl")
+}
+
+// GraphQLDockerComposeTemplate returns the docker-compose.yml for GraphQL template.
+func (t *ProjectTemplates) GraphQLDockerComposeTemplate() string {
+	return t.render("graphql/docker-compose.yml.tmpl")
+}
+
+// GraphQLGenerateGoTemplate returns the generate.go file for go generate support.
+func (t *ProjectTemplates) GraphQLGenerateGoTemplate() string {
+	return t.render("graphql/graph/generate.go.tmpl")
+}
+
+// GraphQLResolverTestTemplate returns the schema.resolvers_test.go file for testing resolvers.
+func (t *ProjectTemplates) GraphQLResolverTestTemplate() string {
+	return t.render("graphql/graph/schema.resolvers_test.go.tmpl")
+}
+
+// GraphQLDocsReadmeTemplate returns the docs/README.md for GraphQL template.
+func (t *ProjectTemplates) GraphQLDocsReadmeTemplate() string {
+	return t.render("graphql/docs/README.md.tmpl")
+}
+
+// GraphQLQuickStartTemplate returns the docs/quick-start.md for GraphQL template.
+func (t *ProjectTemplates) GraphQLQuickStartTemplate() string {
+	return t.render("graphql/docs/quick-start.md.tmpl")
+}
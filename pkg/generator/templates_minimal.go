@@ -0,0 +1,65 @@
+package generator
+
+// MinimalGoModTemplate returns the go.mod file content for minimal template.
+// This template excludes JWT and authentication-related dependencies.
+func (t *ProjectTemplates) MinimalGoModTemplate() string {
+	return t.renderFor(TemplateMinimal, "rest/go.mod.tmpl")
+}
+
+// MinimalMainGoTemplate returns the cmd/main.go file content for minimal template.
+// This template has no auth modules, only basic infrastructure.
+func (t *ProjectTemplates) MinimalMainGoTemplate() string {
+	return t.renderFor(TemplateMinimal, "minimal/cmd/main.go.tmpl")
+}
+
+// MinimalRoutesTemplate returns the internal/adapters/http/routes.go for minimal template.
+// This template only has health and swagger routes, no auth endpoints.
+func (t *ProjectTemplates) MinimalRoutesTemplate() string {
+	return t.renderFor(TemplateMinimal, "minimal/internal/adapters/http/routes.go.tmpl")
+}
+
+// MinimalServerTemplate returns the internal/infrastructure/server/server.go for minimal template.
+// This template has no auth middleware dependencies.
+func (t *ProjectTemplates) MinimalServerTemplate() string {
+	return t.renderFor(TemplateMinimal, "minimal/internal/infrastructure/server/server.go.tmpl")
+}
+
+// MinimalEnvTemplate returns the .env.example file content for minimal template.
+// This template has no JWT-related configuration.
+func (t *ProjectTemplates) MinimalEnvTemplate() string {
+	return t.renderFor(TemplateMinimal, "minimal/.env.example.tmpl")
+}
+
+// MinimalReadmeTemplate returns the README.md file content for minimal template.
+// This template focuses on basic API features without authentication.
+func (t *ProjectTemplates) MinimalReadmeTemplate() string {
+	return t.renderFor(TemplateMinimal, "minimal/README.md.tmpl")
+}
+
+// MinimalDatabaseTemplate returns the database.go for minimal template.
+// This template has no User model migrations.
+func (t *ProjectTemplates) MinimalDatabaseTemplate() string {
+	return t.renderFor(TemplateMinimal, "minimal/internal/infrastructure/database/database.go.tmpl")
+}
+
+// MinimalSetupScriptTemplate returns a simplified setup.sh for minimal template.
+// This template has no JWT secret generation.
+func (t *ProjectTemplates) MinimalSetupScriptTemplate() string {
+	return t.renderFor(TemplateMinimal, "minimal/setup.sh.tmpl")
+}
+
+// MinimalDockerComposeTemplate returns simplified docker-compose for minimal template.
+// No JWT_SECRET in environment variables.
+func (t *ProjectTemplates) MinimalDockerComposeTemplate() string {
+	return t.renderFor(TemplateMinimal, "minimal/docker-compose.yml.tmpl")
+}
+
+// MinimalDocsReadmeTemplate returns the docs/README.md for minimal template.
+func (t *ProjectTemplates) MinimalDocsReadmeTemplate() string {
+	return t.renderFor(TemplateMinimal, "minimal/docs/README.md.tmpl")
+}
+
+// MinimalQuickStartTemplate returns the docs/quick-start.md for minimal template.
+func (t *ProjectTemplates) MinimalQuickStartTemplate() string {
+	return t.renderFor(TemplateMinimal, "minimal/docs/quick-start.md.tmpl")
+}
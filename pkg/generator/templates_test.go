@@ -1,9 +1,7 @@
-package main
+package generator
 
 import (
-	"os"
 	"os/exec"
-	"path/filepath"
 	"strconv"
 	"strings"
 	"testing"
@@ -828,7 +826,6 @@ func TestJWTAuthTemplate(t *testing.T) {
 		"type JWTService struct",
 		"func NewJWTService()",
 		"func (s *JWTService) GenerateTokens(",
-		"func GetUserID(c *fiber.Ctx)",
 		"func (s *JWTService) ValidateToken(",
 		"jwt.NewWithClaims",
 		"jwt.SigningMethodHS256",
@@ -857,6 +854,7 @@ func TestJWTMiddlewareTemplate(t *testing.T) {
 		"JWTAlg: jwtware.HS256",
 		"ErrorHandler:",
 		"fiber.StatusUnauthorized",
+		"func GetUserID(c *fiber.Ctx)",
 		projectName + "/pkg/config",
 	}
 
@@ -1004,41 +1002,8 @@ func TestE2EDockerImageSize(t *testing.T) {
 		t.Skip("Docker not available, skipping Docker image size test")
 	}
 
-	// Create temporary project
-	tmpDir := t.TempDir()
-	projectName := "test-docker-size"
-	projectPath := filepath.Join(tmpDir, projectName)
-
-	// Use createProjectStructure function (with full template)
-	if err := createProjectStructure(projectPath, TemplateFull); err != nil {
-		t.Fatalf("Failed to create project structure: %v", err)
-	}
-
-	// Generate files using templates
-	templates := NewProjectTemplates(projectName)
-
-	// Create Dockerfile
-	dockerfilePath := filepath.Join(projectPath, "Dockerfile")
-	if err := os.WriteFile(dockerfilePath, []byte(templates.DockerfileTemplate()), 0644); err != nil {
-		t.Fatalf("Failed to create Dockerfile: %v", err)
-	}
-
-	// Create go.mod
-	gomodPath := filepath.Join(projectPath, "go.mod")
-	if err := os.WriteFile(gomodPath, []byte(templates.GoModTemplate()), 0644); err != nil {
-		t.Fatalf("Failed to create go.mod: %v", err)
-	}
-
-	// Create cmd directory and main.go
-	cmdDir := filepath.Join(projectPath, "cmd")
-	if err := os.MkdirAll(cmdDir, defaultDirPerm); err != nil {
-		t.Fatalf("Failed to create cmd directory: %v", err)
-	}
-
-	mainGoPath := filepath.Join(cmdDir, "main.go")
-	if err := os.WriteFile(mainGoPath, []byte(templates.MainGoTemplate()), 0644); err != nil {
-		t.Fatalf("Failed to create main.go: %v", err)
-	}
+	// Generate the project (with full template)
+	projectPath := writeTestProject(t, testProjectOptions("test-docker-size", TemplateFull))
 
 	// Run go mod tidy
 	cmd := exec.Command("go", "mod", "tidy")
@@ -1097,3 +1062,36 @@ func TestE2EDockerImageSize(t *testing.T) {
 		}
 	}
 }
+
+// TestTemplateTreeRenders verifies that every embedded template file parses and renders
+func TestTemplateTreeRenders(t *testing.T) {
+	for _, layer := range []string{"common", "rest", "full", "minimal", "graphql", "legacy"} {
+		paths, contents, err := renderLayers([]string{layer}, newTemplateData("render-test"))
+		if err != nil {
+			t.Fatalf("renderLayers(%s) error = %v", layer, err)
+		}
+		if len(paths) == 0 {
+			t.Errorf("layer %s should contain template files", layer)
+		}
+		for _, p := range paths {
+			if strings.Contains(contents[p], "<no value>") {
+				t.Errorf("%s/%s rendered a missing value", layer, p)
+			}
+		}
+	}
+}
+
+// TestTemplateDataHasFeature tests feature lookup on the template context
+func TestTemplateDataHasFeature(t *testing.T) {
+	data := newTemplateData("feature-test")
+	if data.HasFeature("auth") {
+		t.Error("default template data should not enable features")
+	}
+	data.Features = []string{"auth", "swagger"}
+	if !data.HasFeature("swagger") {
+		t.Error("HasFeature should report enabled features")
+	}
+	if data.ModulePath != "feature-test" || data.DBDriver != DefaultDBDriver {
+		t.Errorf("unexpected default template data: %+v", data)
+	}
+}
@@ -0,0 +1,91 @@
+package generator
+
+// ModelsUserTemplate returns the internal/models/user.go file content with domain entities
+func (t *ProjectTemplates) ModelsUserTemplate() string {
+	return t.render("features/users/internal/models/user.go.tmpl")
+}
+
+// UserEntityTemplate is deprecated - models are now in the models package
+func (t *ProjectTemplates) UserEntityTemplate() string {
+	return ``
+}
+
+// UserRefreshTokenTemplate is deprecated - models are now in the models package
+func (t *ProjectTemplates) UserRefreshTokenTemplate() string {
+	return ``
+}
+
+// UserInterfacesTemplate returns the internal/interfaces/services.go file content
+func (t *ProjectTemplates) UserInterfacesTemplate() string {
+	return t.render("features/auth/internal/interfaces/services.go.tmpl")
+}
+
+// UserRepositoryInterfaceTemplate returns the internal/interfaces/user_repository.go file content
+func (t *ProjectTemplates) UserRepositoryInterfaceTemplate() string {
+	return t.render("features/users/internal/interfaces/user_repository.go.tmpl")
+}
+
+// UserRepositoryTemplate returns the internal/adapters/repository/user_repository.go file content
+func (t *ProjectTemplates) UserRepositoryTemplate() string {
+	return t.render("features/users/internal/adapters/repository/user_repository.go.tmpl")
+}
+
+// DomainErrorsTemplate returns the internal/domain/errors.go file content
+func (t *ProjectTemplates) DomainErrorsTemplate() string {
+	return t.render("features/users/internal/domain/errors.go.tmpl")
+}
+
+// ErrorHandlerMiddlewareTemplate returns the internal/adapters/middleware/error_handler.go file content
+func (t *ProjectTemplates) ErrorHandlerMiddlewareTemplate() string {
+	return t.render("features/users/internal/adapters/middleware/error_handler.go.tmpl")
+}
+
+// UserServiceTemplate returns the internal/domain/user/service.go file content
+func (t *ProjectTemplates) UserServiceTemplate() string {
+	return t.render("features/users/internal/domain/user/service.go.tmpl")
+}
+
+// UserHandlerTemplate returns the internal/adapters/handlers/user_handler.go file content
+func (t *ProjectTemplates) UserHandlerTemplate() string {
+	return t.render("features/users/internal/adapters/handlers/user_handler.go.tmpl")
+}
+
+// HandlerModuleTemplate returns the internal/adapters/handlers/module.go file content
+func (t *ProjectTemplates) HandlerModuleTemplate() string {
+	return t.render("features/users/internal/adapters/handlers/module.go.tmpl")
+}
+
+// AuthHandlerTemplate returns the internal/adapters/handlers/auth_handler.go file content
+func (t *ProjectTemplates) AuthHandlerTemplate() string {
+	return t.render("features/users/internal/adapters/handlers/auth_handler.go.tmpl")
+}
+
+// JWTAuthTemplate returns the pkg/auth/jwt.go file content
+func (t *ProjectTemplates) JWTAuthTemplate() string {
+	return t.render("features/auth/pkg/auth/jwt.go.tmpl")
+}
+
+// JWTMiddlewareTemplate returns the pkg/auth/middleware.go file content
+func (t *ProjectTemplates) JWTMiddlewareTemplate() string {
+	return t.render("features/auth/pkg/auth/middleware.go.tmpl")
+}
+
+// UserModuleTemplate returns the internal/domain/user/module.go file content
+func (t *ProjectTemplates) UserModuleTemplate() string {
+	return t.render("features/users/internal/domain/user/module.go.tmpl")
+}
+
+// RepositoryModuleTemplate returns the internal/adapters/repository/module.go file content
+func (t *ProjectTemplates) RepositoryModuleTemplate() string {
+	return t.render("features/users/internal/adapters/repository/module.go.tmpl")
+}
+
+// AuthModuleTemplate returns the pkg/auth/module.go file content
+func (t *ProjectTemplates) AuthModuleTemplate() string {
+	return t.render("features/auth/pkg/auth/module.go.tmpl")
+}
+
+// RoutesTemplate returns the internal/adapters/http/routes.go file content
+func (t *ProjectTemplates) RoutesTemplate() string {
+	return t.render("features/users/internal/adapters/http/routes.go.tmpl")
+}
@@ -0,0 +1,180 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+// UpgradeResult lists the files changed by an upgrade, relative to the project root
+type UpgradeResult struct {
+	// FromVersion and FromTemplates are the versions the project was generated with
+	FromVersion, FromTemplates string
+	// Updated files were untouched since generation and replaced by their new version
+	Updated []string
+	// Added files are new in the templates
+	Added []string
+	// Removed files were untouched since generation and are no longer generated
+	Removed []string
+	// Merged files were edited locally and merged with their new version
+	Merged []string
+	// Conflicted files were merged with conflict markers where both sides changed
+	Conflicted []string
+	// Kept files were edited locally and are no longer generated
+	Kept []string
+	// Skipped files were deleted locally and are left deleted
+	Skipped []string
+}
+
+// Changed reports whether the upgrade changes anything in the project. Files
+// deleted locally stay deleted at every upgrade and do not count as changes.
+func (r *UpgradeResult) Changed() bool {
+	return len(r.Updated)+len(r.Added)+len(r.Removed)+len(r.Merged)+len(r.Conflicted)+len(r.Kept) > 0
+}
+
+// Upgrade re-renders the project in projectPath with the current
+// templates and the options recorded in its manifest. Files left untouched
+// since generation are replaced; edited files are merged three ways with the
+// files as generated, archived in the project. The manifest and the archive
+// are then rewritten for the next upgrade. Nothing is written in dry-run mode.
+func Upgrade(projectPath string, dryRun bool) (*UpgradeResult, error) {
+	manifest, err := LoadManifest(projectPath)
+	if err != nil {
+		return nil, err
+	}
+	opts, data, rendered, err := renderManifestProject(projectPath, manifest)
+	if err != nil {
+		return nil, err
+	}
+	base, err := readBaseArchive(projectPath)
+	if err != nil {
+		return nil, err
+	}
+	next, err := renderedContents(projectPath, rendered)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &UpgradeResult{FromVersion: manifest.GeneratorVersion, FromTemplates: manifest.TemplatesVersion}
+	var writes []FileGenerator
+	var removes []string
+	paths := slices.Collect(maps.Keys(next))
+	for p := range maps.Keys(manifest.Files) {
+		if _, ok := next[p]; !ok {
+			paths = append(paths, p)
+		}
+	}
+	slices.Sort(paths)
+
+	for _, p := range paths {
+		fullPath := filepath.Join(projectPath, filepath.FromSlash(p))
+		current, err := os.ReadFile(fullPath)
+		exists := err == nil
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+		content, generated := next[p]
+		oldHash, tracked := manifest.Files[p]
+		untouched := exists && tracked && hashContent(current) == oldHash
+
+		switch {
+		case generated && !exists && tracked:
+			result.Skipped = append(result.Skipped, p)
+		case generated && !exists:
+			result.Added = append(result.Added, p)
+			writes = append(writes, FileGenerator{Path: fullPath, Content: content})
+		case generated && string(current) == content:
+			// Already up to date
+		case generated && untouched:
+			result.Updated = append(result.Updated, p)
+			writes = append(writes, FileGenerator{Path: fullPath, Content: content})
+		case generated:
+			merged, conflicts := merge3(base[p], string(current), content)
+			if conflicts > 0 {
+				result.Conflicted = append(result.Conflicted, p)
+			} else if merged != string(current) {
+				result.Merged = append(result.Merged, p)
+			}
+			if merged != string(current) {
+				writes = append(writes, FileGenerator{Path: fullPath, Content: merged})
+			}
+		case !exists:
+			// No longer generated and already deleted
+		case untouched:
+			result.Removed = append(result.Removed, p)
+			removes = append(removes, fullPath)
+		default:
+			result.Kept = append(result.Kept, p)
+		}
+	}
+
+	manifestUpdate, err := manifestFiles(projectPath, opts, data.Year, generationTime(), rendered)
+	if err != nil {
+		return nil, err
+	}
+	if dryRun {
+		return result, nil
+	}
+
+	for _, file := range append(writes, manifestUpdate...) {
+		if err := os.MkdirAll(filepath.Dir(file.Path), defaultDirPerm); err != nil {
+			return nil, fmt.Errorf("failed to create directory for %s: %w", file.Path, err)
+		}
+		// Existing files keep their mode: it only applies to added files
+		rel, err := filepath.Rel(projectPath, file.Path)
+		if err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(file.Path, []byte(file.Content), fileMode(filepath.ToSlash(rel))); err != nil {
+			return nil, fmt.Errorf("failed to write file %s: %w", file.Path, err)
+		}
+	}
+	for _, p := range removes {
+		if err := os.Remove(p); err != nil {
+			return nil, fmt.Errorf("failed to remove %s: %w", p, err)
+		}
+	}
+	return result, nil
+}
+
+// renderManifestProject renders the project in projectPath with the current
+// templates and the options and year recorded in manifest, and with the
+// overrides the project carries, as when it was generated
+func renderManifestProject(projectPath string, manifest *Manifest) (ProjectOptions, TemplateData, []FileGenerator, error) {
+	opts := manifest.Options
+	if err := opts.Validate(); err != nil {
+		return opts, TemplateData{}, nil, fmt.Errorf("invalid options in %s: %w", ManifestPath, err)
+	}
+	var err error
+	if opts.Overrides, err = LoadOverrides(filepath.Join(projectPath, filepath.FromSlash(OverridesDir))); err != nil {
+		return opts, TemplateData{}, nil, err
+	}
+
+	data := opts.TemplateData()
+	if manifest.Year != 0 {
+		data.Year = manifest.Year
+	}
+	rendered, err := renderedProjectFiles(projectPath, opts, data)
+	if err != nil {
+		return opts, TemplateData{}, nil, err
+	}
+	return opts, data, rendered, nil
+}
+
+// renderedContents maps the path of each rendered file, relative to
+// projectPath, to its content
+func renderedContents(projectPath string, rendered []FileGenerator) (map[string]string, error) {
+	contents := make(map[string]string, len(rendered))
+	for _, file := range rendered {
+		rel, err := projectRelPath(projectPath, file.Path)
+		if err != nil {
+			return nil, err
+		}
+		contents[rel] = file.Content
+	}
+	return contents, nil
+}
@@ -0,0 +1,293 @@
+package generator
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestProjectManifest tests that generated projects record the hash of every
+// generated file and archive the files as generated
+func TestProjectManifest(t *testing.T) {
+	opts := testProjectOptions("manifest-app", TemplateMinimal)
+	opts.ModulePath = "github.com/acme/manifest-app"
+	opts.Features = []string{"auth"}
+	projectPath := writeTestProject(t, opts)
+
+	m, err := LoadManifest(projectPath)
+	if err != nil {
+		t.Fatalf("LoadManifest() error = %v", err)
+	}
+	if m.GeneratorVersion != Version || m.TemplatesVersion != TemplatesVersion() || m.Options.Template != TemplateMinimal || m.Year == 0 {
+		t.Errorf("manifest = %+v", m)
+	}
+	if m.Template != TemplateMinimal || m.ModulePath != opts.ModulePath || !slices.Equal(m.Features, []string{"swagger", "auth"}) {
+		t.Errorf("manifest template, module path and features = %q %q %v", m.Template, m.ModulePath, m.Features)
+	}
+	if time.Since(m.GeneratedAt) > time.Minute {
+		t.Errorf("manifest generated_at = %v, want the generation time", m.GeneratedAt)
+	}
+	t.Setenv("SOURCE_DATE_EPOCH", "1700000000")
+	if got := generationTime(); !got.Equal(time.Unix(1700000000, 0)) {
+		t.Errorf("generationTime() = %v, want SOURCE_DATE_EPOCH", got)
+	}
+	if _, ok := m.Files[ManifestPath]; ok {
+		t.Errorf("the manifest should not list itself")
+	}
+
+	base, err := readBaseArchive(projectPath)
+	if err != nil {
+		t.Fatalf("readBaseArchive() error = %v", err)
+	}
+	for _, p := range []string{"go.mod", "cmd/main.go", projectSpecPath} {
+		content, err := os.ReadFile(filepath.Join(projectPath, p))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m.Files[p] != hashContent(content) {
+			t.Errorf("manifest hash of %s = %q, want the hash of the generated file", p, m.Files[p])
+		}
+		if base[p] != string(content) {
+			t.Errorf("base archive should hold %s as generated", p)
+		}
+	}
+	if len(base) != len(m.Files) {
+		t.Errorf("base archive holds %d files, manifest lists %d", len(base), len(m.Files))
+	}
+
+	// Rendering the same project gives the same archive
+	archive, _ := os.ReadFile(filepath.Join(projectPath, baseArchivePath))
+	files, err := projectFiles(projectPath, m.Options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := files[len(files)-1]; !strings.HasSuffix(got.Path, filepath.FromSlash(baseArchivePath)) || got.Content != string(archive) {
+		t.Errorf("base archive should be reproducible")
+	}
+}
+
+// TestLoadManifest tests the error for projects without a manifest and the
+// fields filled in for manifests written before they existed
+func TestLoadManifest(t *testing.T) {
+	if _, err := LoadManifest(t.TempDir()); !errors.Is(err, ErrNoManifest) {
+		t.Errorf("LoadManifest() error = %v, want ErrNoManifest", err)
+	}
+
+	projectPath := t.TempDir()
+	old := `{"generator_version": "v1.0.0", "options": {"name": "old-app", "template": "full"}, "files": {}}`
+	if err := os.WriteFile(filepath.Join(projectPath, ManifestPath), []byte(old), 0644); err != nil {
+		t.Fatal(err)
+	}
+	m, err := LoadManifest(projectPath)
+	if err != nil {
+		t.Fatalf("LoadManifest() error = %v", err)
+	}
+	if m.Template != TemplateFull || m.ModulePath != "old-app" || !slices.Contains(m.Features, "users") {
+		t.Errorf("manifest template, module path and features = %q %q %v", m.Template, m.ModulePath, m.Features)
+	}
+}
+
+// writeGeneration replaces the manifest and base archive of the project so that
+// it looks generated from older templates: base maps paths to their content
+// as generated, and files are left untouched on disk by default
+func writeGeneration(t *testing.T, projectPath string, base map[string]string) {
+	t.Helper()
+	m, err := LoadManifest(projectPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var files []FileGenerator
+	for p, content := range base {
+		files = append(files, FileGenerator{Path: filepath.Join(projectPath, filepath.FromSlash(p)), Content: content})
+		m.Files[p] = hashContent([]byte(content))
+	}
+	m.TemplatesVersion = "old"
+
+	archive, err := baseArchive(projectPath, files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	manifest, _ := json.Marshal(m)
+	if err := os.WriteFile(filepath.Join(projectPath, ManifestPath), manifest, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(projectPath, baseArchivePath), archive, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestUpgradeProject tests that upgrade replaces untouched files, merges
+// edited ones and marks the conflicts
+func TestUpgradeProject(t *testing.T) {
+	projectPath := writeTestProject(t, testProjectOptions("upgrade-app", TemplateMinimal))
+	read := func(p string) string {
+		content, err := os.ReadFile(filepath.Join(projectPath, p))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(content)
+	}
+	write := func(p, content string) {
+		if err := os.WriteFile(filepath.Join(projectPath, p), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	dockerfile, makefile, readme := read("Dockerfile"), read("Makefile"), read("README.md")
+
+	// Older templates: a different Dockerfile, a Makefile without its last line,
+	// a README with another title and two files no longer generated
+	oldDockerfile := strings.Replace(dockerfile, "AS builder", "AS build", 1)
+	makefileLines := splitLines(makefile)
+	oldMakefile := strings.Join(makefileLines[:len(makefileLines)-1], "")
+	readmeLines := splitLines(readme)
+	oldReadme := "# Old title\n" + strings.Join(readmeLines[1:], "")
+	writeGeneration(t, projectPath, map[string]string{
+		"Dockerfile": oldDockerfile,
+		"Makefile":   oldMakefile,
+		"README.md":  oldReadme,
+		"old.txt":    "obsolete\n",
+		"notes.txt":  "generated\n",
+		"setup.sh":   read("setup.sh"),
+	})
+
+	// Local changes: the Dockerfile is untouched, the Makefile and the README
+	// title are edited, notes.txt is edited, setup.sh is deleted and go.mod is new
+	write("Dockerfile", oldDockerfile)
+	write("Makefile", "# mine\n"+oldMakefile)
+	write("README.md", "# My title\n"+strings.Join(readmeLines[1:], ""))
+	write("old.txt", "obsolete\n")
+	write("notes.txt", "edited\n")
+	os.Remove(filepath.Join(projectPath, "setup.sh"))
+	m, _ := LoadManifest(projectPath)
+	delete(m.Files, "go.mod")
+	manifest, _ := json.Marshal(m)
+	write(ManifestPath, string(manifest))
+	os.Remove(filepath.Join(projectPath, "go.mod"))
+
+	// Dry run reports without writing
+	result, err := Upgrade(projectPath, true)
+	if err != nil {
+		t.Fatalf("Upgrade() dry run error = %v", err)
+	}
+	if read("Dockerfile") != oldDockerfile || !slices.Equal(result.Updated, []string{"Dockerfile"}) {
+		t.Errorf("dry run should report the update without writing it, got %+v", result)
+	}
+
+	result, err = Upgrade(projectPath, false)
+	if err != nil {
+		t.Fatalf("Upgrade() error = %v", err)
+	}
+	want := UpgradeResult{
+		FromVersion:   Version,
+		FromTemplates: "old",
+		Updated:       []string{"Dockerfile"},
+		Added:         []string{"go.mod"},
+		Removed:       []string{"old.txt"},
+		Merged:        []string{"Makefile"},
+		Conflicted:    []string{"README.md"},
+		Kept:          []string{"notes.txt"},
+		Skipped:       []string{"setup.sh"},
+	}
+	if got := *result; !equalUpgradeResults(got, want) {
+		t.Errorf("Upgrade() = %+v, want %+v", got, want)
+	}
+
+	if read("Dockerfile") != dockerfile || read("go.mod") == "" {
+		t.Errorf("untouched and new files should be written as rendered")
+	}
+	if read("Makefile") != "# mine\n"+makefile {
+		t.Errorf("Makefile should keep the local change and get the new line, got:\n%s", read("Makefile"))
+	}
+	if !strings.HasPrefix(read("README.md"), "<<<<<<< yours\n# My title\n=======\n"+readmeLines[0]+">>>>>>> template\n") {
+		t.Errorf("README.md should hold conflict markers, got:\n%s", read("README.md"))
+	}
+	if _, err := os.Stat(filepath.Join(projectPath, "old.txt")); !os.IsNotExist(err) {
+		t.Errorf("old.txt should be removed")
+	}
+	if read("notes.txt") != "edited\n" {
+		t.Errorf("notes.txt should be kept")
+	}
+
+	// The manifest now describes the current templates
+	m, err = LoadManifest(projectPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.TemplatesVersion != TemplatesVersion() || m.Files["Dockerfile"] != hashContent([]byte(dockerfile)) {
+		t.Errorf("manifest should be rewritten, got %+v", m)
+	}
+	if _, ok := m.Files["old.txt"]; ok {
+		t.Errorf("manifest should no longer list old.txt")
+	}
+
+	// Once the conflict is resolved, the project is up to date
+	write("README.md", "# My title\n"+strings.Join(readmeLines[1:], ""))
+	if result, err := Upgrade(projectPath, false); err != nil || result.Changed() {
+		t.Errorf("second Upgrade() = %+v, %v; want no changes", result, err)
+	}
+}
+
+// TestUpgradeFileModes tests that added files get their generated mode and
+// that rewritten files keep their mode
+func TestUpgradeFileModes(t *testing.T) {
+	projectPath := writeTestProject(t, testProjectOptions("upgrade-modes", TemplateMinimal))
+	dockerfile := filepath.Join(projectPath, "Dockerfile")
+	content, err := os.ReadFile(dockerfile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// setup.sh is new in the current templates and the untouched Dockerfile,
+	// made private, changed since generation
+	oldDockerfile := strings.Replace(string(content), "AS builder", "AS build", 1)
+	writeGeneration(t, projectPath, map[string]string{"Dockerfile": oldDockerfile})
+	if err := os.WriteFile(dockerfile, []byte(oldDockerfile), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod(dockerfile, 0600); err != nil {
+		t.Fatal(err)
+	}
+	m, err := LoadManifest(projectPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	delete(m.Files, "setup.sh")
+	manifest, _ := json.Marshal(m)
+	if err := os.WriteFile(filepath.Join(projectPath, ManifestPath), manifest, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(filepath.Join(projectPath, "setup.sh")); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := Upgrade(projectPath, false)
+	if err != nil {
+		t.Fatalf("Upgrade() error = %v", err)
+	}
+	if !slices.Contains(result.Added, "setup.sh") || !slices.Equal(result.Updated, []string{"Dockerfile"}) {
+		t.Fatalf("Upgrade() = %+v, want setup.sh added and Dockerfile updated", result)
+	}
+	for p, want := range map[string]os.FileMode{"setup.sh": 0755, "Dockerfile": 0600} {
+		info, err := os.Stat(filepath.Join(projectPath, p))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if mode := info.Mode().Perm(); mode != want {
+			t.Errorf("%s mode = %v, want %v", p, mode, want)
+		}
+	}
+}
+
+// equalUpgradeResults reports whether two upgrade results list the same files
+func equalUpgradeResults(a, b UpgradeResult) bool {
+	return a.FromVersion == b.FromVersion && a.FromTemplates == b.FromTemplates &&
+		slices.Equal(a.Updated, b.Updated) && slices.Equal(a.Added, b.Added) &&
+		slices.Equal(a.Removed, b.Removed) && slices.Equal(a.Merged, b.Merged) &&
+		slices.Equal(a.Conflicted, b.Conflicted) && slices.Equal(a.Kept, b.Kept) &&
+		slices.Equal(a.Skipped, b.Skipped)
+}
@@ -0,0 +1,38 @@
+package generator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/fs"
+	"sync"
+)
+
+// Version is the version of create-go-starter, set at build time with
+// -ldflags "-X github.com/tky0065/go-starter-kit/pkg/generator.Version=v1.2.3"
+var Version = "dev"
+
+// TemplatesVersion returns a digest of the embedded template tree. It changes
+// whenever a template is added, removed or edited, so it identifies the
+// templates a project was generated from even for development builds.
+var TemplatesVersion = sync.OnceValue(func() string {
+	h := sha256.New()
+	// WalkDir visits the files in lexical order, keeping the digest stable
+	err := fs.WalkDir(templateFS, templateRoot, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		content, err := fs.ReadFile(templateFS, p)
+		if err != nil {
+			return err
+		}
+		h.Write([]byte(p + "\x00"))
+		h.Write(content)
+		h.Write([]byte{0})
+		return nil
+	})
+	if err != nil {
+		// The tree is embedded in the binary: reading it cannot fail
+		panic(err)
+	}
+	return hex.EncodeToString(h.Sum(nil))[:12]
+})
@@ -1,8 +1,11 @@
 package utils
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
+
+	"golang.org/x/mod/module"
 )
 
 // Valid Go module name pattern
@@ -24,3 +27,22 @@ func ValidateGoModuleName(name string) error {
 
 	return nil
 }
+
+// ValidateModulePath validates a full Go module path such as
+// github.com/org/service using the same rules as the go command
+// (golang.org/x/mod/module.CheckPath).
+func ValidateModulePath(path string) error {
+	if path == "" {
+		return fmt.Errorf("module path cannot be empty")
+	}
+
+	if err := module.CheckPath(path); err != nil {
+		var pathErr *module.InvalidPathError
+		if errors.As(err, &pathErr) {
+			err = pathErr.Err
+		}
+		return fmt.Errorf("invalid module path '%s': %v", path, err)
+	}
+
+	return nil
+}